@@ -0,0 +1,42 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type advisory struct {
+	wrapped
+	w io.Writer
+}
+
+// Advisory returns a Checker whose findings are reported but never result in
+// an error. This is useful for including checkers in a Group whose findings are
+// informational, such as golint.
+//
+// Findings are written to w, or os.Stderr if w is nil, with each line prefixed by
+// "advisory: " and the name of c, to distinguish them from errors returned by the
+// Group. Operational errors returned by c are returned unchanged, so a missing
+// tool is still reported as a failure.
+func Advisory(w io.Writer, c Checker) Checker {
+	if w == nil {
+		w = os.Stderr
+	}
+	return advisory{w: w, wrapped: wrapped{c}}
+}
+
+func (a advisory) Check(pkgs ...string) error {
+	err := a.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	name := checkerName(a.checker)
+	for _, e := range findings(found) {
+		fmt.Fprintf(a.w, "advisory: %s: %s\n", name, e)
+	}
+	return withOps(nil, ops)
+}
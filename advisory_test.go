@@ -0,0 +1,46 @@
+package lint_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestAdvisory(t *testing.T) {
+	var out bytes.Buffer
+	err := lint.Group{expectRecursive, lint.Advisory(&out, twoErrors)}.Check("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, out.String() == "advisory: lint_test.checkFn: err1\nadvisory: lint_test.checkFn: err2\n", out.String())
+
+	// Fatal findings are still returned
+	out.Reset()
+	err = lint.Group{ungroupedError, lint.Advisory(&out, twoErrors)}.Check("./...")
+	assert(t, err != nil && err.Error() == "lint_test.checkFn: ungrouped: 1", fmt.Sprintf("%v", err))
+	assert(t, out.String() == "advisory: lint_test.checkFn: err1\nadvisory: lint_test.checkFn: err2\n", out.String())
+
+	out.Reset()
+	err = lint.Advisory(&out, expectRecursive).Check("./...")
+	assert(t, err == nil && out.Len() == 0, fmt.Sprintf("%v: %s", err, out.String()))
+}
+
+func TestAdvisoryOperational(t *testing.T) {
+	var out bytes.Buffer
+	err := lint.Advisory(&out, missingTool).Check("./...")
+	_, ops := lint.Split(err)
+	assert(t, ops != nil && err.Error() == "failed to find binary: missing", fmt.Sprintf("%v", err))
+	assert(t, out.Len() == 0, out.String())
+
+	out.Reset()
+	err = lint.Advisory(&out, lint.Group{twoErrors, missingTool}).Check("./...")
+	findings, ops := lint.Split(err)
+	assert(t, findings == nil && err.Error() == "lint_test.checkFn: failed to find binary: missing",
+		fmt.Sprintf("%v", err))
+	assert(t, ops != nil && out.String() == "advisory: lint.Group: lint_test.checkFn: err1\n"+
+		"advisory: lint.Group: lint_test.checkFn: err2\n", out.String())
+
+	a := lint.Advisory(nil, lint.Stub())
+	assert(t, a.(lint.Named).Name() == "lint.Stub", a.(lint.Named).Name())
+	assert(t, lint.CategoryOf(a) == lint.CategoryOf(lint.Stub()), lint.CategoryOf(a))
+}
@@ -5,11 +5,13 @@ import "github.com/surullabs/lint/checkers"
 
 // Check runs the aligncheck linter (https://github.com/opennota/check)
 type Check struct {
+	// Command sets the environment and working directory used to run aligncheck
+	checkers.Command
 }
 
 // Check runs aligncheck and returns any errors found.
 func (c Check) Check(pkgs ...string) error {
-	return checkers.Lint("aligncheck",
+	return checkers.LintCommand(c.Command, "aligncheck",
 		"github.com/opennota/check",
 		"github.com/opennota/check/cmd/aligncheck", pkgs)
 }
@@ -0,0 +1,191 @@
+// Package analysisutil runs analyzers written using golang.org/x/tools/go/analysis
+// as lint checkers.
+package analysisutil
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// TextEdit is an edit suggested by an analyzer, which replaces the bytes between
+// Offset and End in File with NewText.
+type TextEdit struct {
+	File    string
+	Offset  int
+	End     int
+	NewText string
+}
+
+// Fault is a single diagnostic reported by an analyzer.
+type Fault struct {
+	// Pos is the position of the diagnostic.
+	Pos token.Position
+	// Message is the message of the diagnostic.
+	Message string
+	// Suggestions holds the edits of all fixes suggested for the diagnostic, in the
+	// order they were suggested.
+	Suggestions []TextEdit
+}
+
+// String returns f in the form file:line:col: message, as reported by Check.
+func (f Fault) String() string { return fmt.Sprintf("%s: %s", f.Pos, f.Message) }
+
+// Check runs an analyzer on packages loaded from source. Analyzers which use
+// facts are not supported.
+type Check struct {
+	// Analyzer is the analyzer to run.
+	Analyzer *analysis.Analyzer
+}
+
+// FromAnalyzer returns a Checker running a.
+//
+//    lint.Group{analysisutil.FromAnalyzer(nilness.Analyzer)}
+func FromAnalyzer(a *analysis.Analyzer) Check {
+	return Check{Analyzer: a}
+}
+
+// Name returns the name of the analyzer.
+func (c Check) Name() string { return c.Analyzer.Name }
+
+// Check runs the analyzer for pkgs and returns the faults found, formatted as
+// described by Fault.String.
+func (c Check) Check(pkgs ...string) error {
+	faults, err := c.Faults(pkgs...)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, f := range faults {
+		errs = append(errs, f.String())
+	}
+	return checkers.Error(errs...)
+}
+
+// Faults runs the analyzer for pkgs and returns the faults found, including any
+// suggested fixes. An OperationalError is returned if a package cannot be loaded,
+// has type errors, or the analyzer fails.
+func (c Check) Faults(pkgs ...string) ([]Fault, error) {
+	if len(c.Analyzer.FactTypes) > 0 {
+		return nil, checkers.Operational(fmt.Errorf("%s uses facts, which are not supported", c.Analyzer.Name))
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, checkers.Operational(err)
+	}
+	var faults []Fault
+	for _, pkg := range pkgs {
+		p, err := checkers.Load(pkg)
+		if err != nil {
+			return nil, checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+		}
+		for _, sub := range p.Pkgs {
+			b, err := build.Import(sub, wd, 0)
+			if err != nil {
+				return nil, checkers.Operational(fmt.Errorf("failed to find %s: %v", sub, err))
+			}
+			found, err := c.analyze(b)
+			if err != nil {
+				return nil, checkers.Operational(fmt.Errorf("%s: %s: %v", c.Analyzer.Name, sub, err))
+			}
+			faults = append(faults, found...)
+		}
+	}
+	return faults, nil
+}
+
+// analyze runs the analyzer, and the analyzers it requires, on the package b.
+func (c Check) analyze(b *build.Package) ([]Fault, error) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range b.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(b.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	info := &types.Info{
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Defs:       map[*ast.Ident]types.Object{},
+		Uses:       map[*ast.Ident]types.Object{},
+		Implicits:  map[ast.Node]types.Object{},
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+		Scopes:     map[ast.Node]*types.Scope{},
+	}
+	var typeErrs []types.Error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) { typeErrs = append(typeErrs, err.(types.Error)) },
+	}
+	pkg, _ := conf.Check(b.ImportPath, fset, files, info)
+
+	var faults []Fault
+	results := map[*analysis.Analyzer]interface{}{}
+	var run func(a *analysis.Analyzer) error
+	run = func(a *analysis.Analyzer) error {
+		if _, done := results[a]; done {
+			return nil
+		}
+		if len(typeErrs) > 0 && !a.RunDespiteErrors {
+			return typeErrs[0]
+		}
+		deps := map[*analysis.Analyzer]interface{}{}
+		for _, req := range a.Requires {
+			if err := run(req); err != nil {
+				return err
+			}
+			deps[req] = results[req]
+		}
+		pass := &analysis.Pass{
+			Analyzer:   a,
+			Fset:       fset,
+			Files:      files,
+			Pkg:        pkg,
+			TypesInfo:  info,
+			TypesSizes: types.SizesFor("gc", build.Default.GOARCH),
+			TypeErrors: typeErrs,
+			ResultOf:   deps,
+			ReadFile:   ioutil.ReadFile,
+			Report: func(d analysis.Diagnostic) {
+				if a == c.Analyzer {
+					faults = append(faults, newFault(fset, d))
+				}
+			},
+		}
+		res, err := a.Run(pass)
+		if err != nil {
+			return err
+		}
+		results[a] = res
+		return nil
+	}
+	return faults, run(c.Analyzer)
+}
+
+// newFault returns the Fault for d.
+func newFault(fset *token.FileSet, d analysis.Diagnostic) Fault {
+	f := Fault{Pos: fset.Position(d.Pos), Message: d.Message}
+	for _, fix := range d.SuggestedFixes {
+		for _, e := range fix.TextEdits {
+			start := fset.Position(e.Pos)
+			end := start.Offset
+			if e.End.IsValid() {
+				end = fset.Position(e.End).Offset
+			}
+			f.Suggestions = append(f.Suggestions, TextEdit{File: start.Filename, Offset: start.Offset, End: end, NewText: string(e.NewText)})
+		}
+	}
+	return f
+}
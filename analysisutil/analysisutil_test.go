@@ -0,0 +1,111 @@
+package analysisutil_test
+
+import (
+	"go/ast"
+	"go/build"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sridharv/fakegopath"
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/surullabs/lint/analysisutil"
+	"github.com/surullabs/lint/checkers"
+)
+
+// badName reports identifiers named bad and suggests renaming them to good.
+var badName = &analysis.Analyzer{
+	Name: "badname",
+	Doc:  "report identifiers named bad",
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		for _, f := range pass.Files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok && id.Name == "bad" {
+					pass.Report(analysis.Diagnostic{
+						Pos:     id.Pos(),
+						Message: "bad name",
+						SuggestedFixes: []analysis.SuggestedFix{{
+							Message:   "Rename to good",
+							TextEdits: []analysis.TextEdit{{Pos: id.Pos(), End: id.End(), NewText: []byte("good")}},
+						}},
+					})
+				}
+				return true
+			})
+		}
+		return nil, nil
+	},
+}
+
+func TestFromAnalyzer(t *testing.T) {
+	tmp, err := fakegopath.NewTemporaryWithFiles("analysisutil", []fakegopath.SourceFile{
+		{
+			Content: []byte("package fixes\n\nvar bad = 1\n\n// Good is a test variable\nvar Good = bad\n"),
+			Dest:    filepath.Join("fixes", "fixes.go"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+	b, err := build.Import("fixes", "", build.FindOnly)
+	if err != nil {
+		t.Fatalf("failed to find temporary package: %v", err)
+	}
+	file := filepath.Join(b.Dir, "fixes.go")
+
+	c := analysisutil.FromAnalyzer(badName)
+	faults, err := c.Faults("fixes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(faults) != 2 {
+		t.Fatalf("expected 2 faults, got %v", faults)
+	}
+	f := faults[0]
+	if f.Pos.Filename != file || f.Pos.Line != 3 || f.Pos.Column != 5 || f.Message != "bad name" {
+		t.Errorf("unexpected fault %v", f)
+	}
+	expected := []analysisutil.TextEdit{{File: file, Offset: 19, End: 22, NewText: "good"}}
+	if !reflect.DeepEqual(f.Suggestions, expected) {
+		t.Errorf("expected %v, got %v", expected, f.Suggestions)
+	}
+	if s := faults[1].Suggestions; len(s) != 1 || s[0].Offset != 66 || s[0].End != 69 {
+		t.Errorf("unexpected suggestions %v", s)
+	}
+
+	err = c.Check("fixes")
+	if err == nil || !strings.HasPrefix(err.Error(), file+":3:5: bad name") {
+		t.Errorf("unexpected error %v", err)
+	}
+	if c.Name() != "badname" {
+		t.Errorf("unexpected name %s", c.Name())
+	}
+}
+
+func TestFromAnalyzerErrors(t *testing.T) {
+	tmp, err := fakegopath.NewTemporaryWithFiles("analysisutil", []fakegopath.SourceFile{
+		{Content: []byte("package broken\n\nvar x int = \"s\"\n"), Dest: filepath.Join("broken", "broken.go")},
+	})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+
+	err = analysisutil.FromAnalyzer(badName).Check("broken")
+	if _, ok := err.(checkers.OperationalError); !ok {
+		t.Errorf("expected an operational error, got %v", err)
+	}
+
+	facts := &analysis.Analyzer{Name: "facts", Doc: "uses facts", FactTypes: []analysis.Fact{new(fact)}, Run: badName.Run}
+	err = analysisutil.FromAnalyzer(facts).Check("broken")
+	if _, ok := err.(checkers.OperationalError); !ok || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("expected an operational error, got %v", err)
+	}
+}
+
+type fact struct{}
+
+func (*fact) AFact() {}
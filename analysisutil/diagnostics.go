@@ -0,0 +1,98 @@
+package analysisutil
+
+import (
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+// Diagnostics runs c for pkg and returns its findings as diagnostics, so that they
+// can be reported by tools expecting go/analysis diagnostics. Positions are
+// resolved using a FileSet holding the .go files of pkg. Use DiagnosticsIn to
+// resolve positions using an existing FileSet, such as that of an analysis.Pass.
+func Diagnostics(c lint.Checker, pkg string) ([]analysis.Diagnostic, error) {
+	return DiagnosticsIn(token.NewFileSet(), c, pkg)
+}
+
+// DiagnosticsIn is like Diagnostics, but resolves positions using fset. Files of
+// pkg which are not in fset are added to it. Each diagnostic has the category of c,
+// as returned by lint.CategoryOf.
+//
+// Findings without a position, or with a position outside the .go files of pkg,
+// have Pos set to token.NoPos and retain the position in their message. A column
+// beyond the end of its line refers to the end of the line.
+//
+// Operational errors returned by c are returned as an OperationalError, along with
+// any diagnostics for the findings of c.
+func DiagnosticsIn(fset *token.FileSet, c lint.Checker, pkg string) ([]analysis.Diagnostic, error) {
+	p, err := checkers.Load(pkg)
+	if err != nil {
+		return nil, checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+	}
+	files := map[string]*token.File{}
+	fset.Iterate(func(f *token.File) bool {
+		files[f.Name()] = f
+		return true
+	})
+	lines := map[string][]int{}
+	for _, name := range p.Files {
+		if !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, checkers.Operational(fmt.Errorf("failed to read %s: %v", name, err))
+		}
+		f := files[name]
+		if f == nil || f.Size() != len(data) {
+			f = fset.AddFile(name, -1, len(data))
+			f.SetLinesForContent(data)
+			files[name] = f
+		}
+		lines[name] = lineLengths(data)
+	}
+
+	found, ops := lint.Split(c.Check(pkg))
+	category := lint.CategoryOf(c)
+	var diags []analysis.Diagnostic
+	for _, finding := range lint.Lines(found) {
+		d := analysis.Diagnostic{Pos: token.NoPos, Category: category, Message: finding}
+		rest := finding
+		if _, r, ok := lint.CheckerOf(finding); ok {
+			rest = r
+		}
+		if file, line, col, msg, ok := lint.ParseFinding(rest); ok {
+			if abs, err := filepath.Abs(file); err == nil && files[abs] != nil && line >= 1 && line <= len(lines[abs]) {
+				if col < 1 {
+					col = 1
+				}
+				if length := lines[abs][line-1]; col > length+1 {
+					col = length + 1
+				}
+				d.Pos = files[abs].LineStart(line) + token.Pos(col-1)
+				d.Message = msg
+			}
+		}
+		diags = append(diags, d)
+	}
+	if ops != nil {
+		return diags, checkers.Operational(ops)
+	}
+	return diags, nil
+}
+
+// lineLengths returns the length in bytes of each line in data, excluding newlines.
+func lineLengths(data []byte) []int {
+	var lengths []int
+	for _, line := range strings.Split(string(data), "\n") {
+		lengths = append(lengths, len(line))
+	}
+	return lengths
+}
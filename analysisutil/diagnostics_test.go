@@ -0,0 +1,109 @@
+package analysisutil_test
+
+import (
+	"fmt"
+	"go/build"
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/sridharv/fakegopath"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/analysisutil"
+	"github.com/surullabs/lint/checkers"
+	"github.com/surullabs/lint/errcheck"
+)
+
+const unchecked = `package diag
+
+import "os"
+
+// Remove is a test function
+func Remove() {
+	os.Remove("somefile")
+}
+`
+
+// tempPackage creates a package named pkg holding unchecked. Each test uses its own
+// package, since checkers.Load caches packages by name.
+func tempPackage(t *testing.T, pkg string) (*fakegopath.Temporary, string) {
+	tmp, err := fakegopath.NewTemporaryWithFiles("diagnostics", []fakegopath.SourceFile{
+		{Content: []byte(unchecked), Dest: filepath.Join(pkg, "diag.go")},
+	})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	b, err := build.Import(pkg, "", build.FindOnly)
+	if err != nil {
+		tmp.Reset()
+		t.Fatalf("failed to find temporary package: %v", err)
+	}
+	return tmp, filepath.Join(b.Dir, "diag.go")
+}
+
+func TestDiagnostics(t *testing.T) {
+	tmp, file := tempPackage(t, "diag")
+	defer tmp.Reset()
+
+	fset := token.NewFileSet()
+	diags, err := analysisutil.DiagnosticsIn(fset, lint.Group{errcheck.Check{}}, "diag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic, got %v", diags)
+	}
+	pos := fset.Position(diags[0].Pos)
+	if pos.Filename != file || pos.Line != 7 || pos.Column != 2 {
+		t.Errorf("unexpected position %v", pos)
+	}
+	if diags[0].Message != `os.Remove("somefile")` || diags[0].Category != lint.CategoryOf(errcheck.Check{}) {
+		t.Errorf("unexpected diagnostic %#v", diags[0])
+	}
+
+	diags, err = analysisutil.Diagnostics(errcheck.Check{}, "diag")
+	if err != nil || len(diags) != 1 || !diags[0].Pos.IsValid() {
+		t.Errorf("unexpected diagnostics %v: %v", diags, err)
+	}
+}
+
+func TestDiagnosticsPositions(t *testing.T) {
+	tmp, file := tempPackage(t, "diagpos")
+	defer tmp.Reset()
+
+	fset := token.NewFileSet()
+	diags, err := analysisutil.DiagnosticsIn(fset, lint.Stub(
+		file+":5:99: column past the end",
+		file+":0:1: line zero",
+		file+":99:1: line past the end",
+		"other.go:1:1: not in package",
+		"no position",
+	), "diagpos")
+	if err != nil || len(diags) != 5 {
+		t.Fatalf("unexpected diagnostics %v: %v", diags, err)
+	}
+	if pos := fset.Position(diags[0].Pos); pos.Line != 5 || pos.Column != 29 {
+		t.Errorf("unexpected position %v", pos)
+	}
+	for _, d := range diags[1:] {
+		if d.Pos != token.NoPos {
+			t.Errorf("expected no position for %q", d.Message)
+		}
+	}
+
+	// Files already in the FileSet are reused.
+	if _, err = analysisutil.DiagnosticsIn(fset, lint.Stub(), "diagpos"); err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	fset.Iterate(func(*token.File) bool { count++; return true })
+	if count != 1 {
+		t.Errorf("expected a single file, got %d", count)
+	}
+
+	_, err = analysisutil.Diagnostics(lint.StubError(checkers.Operational(fmt.Errorf("not installed"))), "diagpos")
+	if _, ok := err.(checkers.OperationalError); !ok {
+		t.Errorf("expected an operational error, got %v", err)
+	}
+}
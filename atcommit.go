@@ -0,0 +1,82 @@
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// AtCommit runs c for pkg as of the git commit ref, such as a tag or commit hash.
+// A temporary git worktree is created for ref, and c is run on the directory
+// corresponding to pkg in it. The worktree is removed once c completes. Paths in
+// the returned findings are replaced by the paths of the same files in the current
+// checkout, and each finding is prefixed by ref, as in
+//
+//    v1.2.0: /src/example.com/p/a.go:3:1: exported A should have comment
+//
+// pkg must be a single package, and is passed to c as a path relative to the
+// current directory, since the worktree is not in the GOPATH. A failure to create the worktree is returned
+// as an operational error.
+func AtCommit(ref string, c Checker, pkg string) error {
+	p, err := checkers.Load(pkg)
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+	}
+	dir, err := filepath.Abs(p.Build.Dir)
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to find directory of %s: %v", pkg, err))
+	}
+	top, err := git(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return err
+	}
+	if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = resolved
+	}
+	rel, err := filepath.Rel(top, dir)
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to find %s in %s: %v", dir, top, err))
+	}
+	tmp, err := ioutil.TempDir("", "lintatcommit")
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to create worktree directory: %v", err))
+	}
+	defer os.RemoveAll(tmp)
+	tree := filepath.Join(tmp, "tree")
+	if _, err = git(top, "worktree", "add", "--detach", tree, ref); err != nil {
+		return err
+	}
+	defer git(top, "worktree", "remove", "--force", tree)
+
+	// Import paths cannot be absolute, so the package is passed to c relative to the
+	// current directory.
+	wd, err := os.Getwd()
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to find cwd: %v", err))
+	}
+	target, err := filepath.Rel(wd, filepath.Join(tree, rel))
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to find worktree path: %v", err))
+	}
+	if target = filepath.ToSlash(target); !strings.HasPrefix(target, "../") {
+		target = "./" + target
+	}
+	defer checkers.Unload(target)
+	paths := []string{tree + string(filepath.Separator)}
+	if resolved, err := filepath.EvalSymlinks(tree); err == nil && resolved != tree {
+		paths = append(paths, resolved+string(filepath.Separator))
+	}
+	if relTree, err := filepath.Rel(wd, tree); err == nil {
+		paths = append(paths, relTree+string(filepath.Separator))
+	}
+	return mapFindings(c.Check(target), func(finding string) string {
+		for _, path := range paths {
+			finding = strings.Replace(finding, path, top+string(filepath.Separator), -1)
+		}
+		return ref + ": " + finding
+	})
+}
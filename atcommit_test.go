@@ -0,0 +1,96 @@
+package lint_test
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+// todos reports lines containing TODO in the go files of pkgs.
+var todos = checkFn(func(pkgs ...string) error {
+	files, err := checkers.GoFiles(pkgs...)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		scanner := bufio.NewScanner(f)
+		for line := 1; scanner.Scan(); line++ {
+			if strings.Contains(scanner.Text(), "TODO") {
+				errs = append(errs, fmt.Sprintf("%s:%d: found TODO", file, line))
+			}
+		}
+		f.Close()
+	}
+	return checkers.Error(errs...)
+})
+
+func TestAtCommit(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "atcommit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	repo, err := filepath.EvalSymlinks(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=lint", "-c", "user.email=lint@example.com"}, args...)...)
+		cmd.Dir = repo
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+	pkg := filepath.Join(repo, "p")
+	file := filepath.Join(pkg, "p.go")
+	if err = os.MkdirAll(pkg, 0755); err != nil {
+		t.Fatal(err)
+	}
+	git("init", "-q")
+	if err = ioutil.WriteFile(file, []byte("package p\n\n// TODO: remove\nvar x = 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "p/p.go")
+	git("commit", "-q", "-m", "first")
+	git("tag", "v1")
+	if err = ioutil.WriteFile(file, []byte("package p\n\nvar x = 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("commit", "-q", "-a", "-m", "second")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := filepath.Rel(wd, pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg = filepath.ToSlash(rel)
+
+	err = lint.AtCommit("v1", todos, pkg)
+	assert(t, reflect.DeepEqual(errorList(err), []string{"v1: " + file + ":3: found TODO"}), fmt.Sprintf("%v", err))
+	assert(t, lint.AtCommit("HEAD", todos, pkg) == nil, "expected no error at HEAD")
+
+	// The worktrees are removed.
+	out, err := exec.Command("git", "-C", repo, "worktree", "list", "--porcelain").Output()
+	assert(t, err == nil && strings.Count(string(out), "worktree ") == 1, string(out))
+
+	err = lint.AtCommit("nosuchref", todos, pkg)
+	_, ops := lint.Split(err)
+	assert(t, ops != nil && strings.Contains(err.Error(), "git worktree add"), fmt.Sprintf("%v", err))
+}
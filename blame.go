@@ -0,0 +1,120 @@
+package lint
+
+import (
+	"bufio"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type blame struct {
+	wrapped
+	fn func(file string) (map[int]string, error)
+}
+
+// WithBlame returns a Checker that runs c and appends the author of the line
+// reported by each finding, as in
+//
+//    a.go:12:2: error return value not checked (author: Jane Doe <jane@example.com>)
+//
+// Authors are found using GitBlame. Findings without a position, or for lines
+// whose author cannot be found, are returned unchanged.
+func WithBlame(c Checker) Checker {
+	return WithBlameFunc(GitBlame, c)
+}
+
+// WithBlameFunc is like WithBlame, but finds authors using fn, which returns the
+// author of each line of file, keyed by line number. fn is called at most once for
+// each file in a single run.
+func WithBlameFunc(fn func(file string) (map[int]string, error), c Checker) Checker {
+	return blame{fn: fn, wrapped: wrapped{c}}
+}
+
+func (b blame) Check(pkgs ...string) error {
+	authors := map[string]map[int]string{}
+	return mapFindings(b.checker.Check(pkgs...), func(finding string) string {
+		_, file, line, _, _, ok := parseLabeled(finding)
+		if !ok {
+			return finding
+		}
+		lines, cached := authors[file]
+		if !cached {
+			// Failures are cached too, so that they are not repeated for each finding.
+			lines, _ = b.fn(file)
+			authors[file] = lines
+		}
+		if author := lines[line]; author != "" {
+			return finding + " (author: " + author + ")"
+		}
+		return finding
+	})
+}
+
+// GitBlame returns the author of each line of file, as found using
+//   git blame --porcelain
+//
+// Authors are of the form "name <email>".
+func GitBlame(file string) (map[int]string, error) {
+	cmd := exec.Command("git", "blame", "--porcelain", "--", filepath.Base(file))
+	cmd.Dir = filepath.Dir(file)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git blame failed: %s: %v", file, err)
+	}
+	return parseBlame(string(out))
+}
+
+// parseBlame returns the authors of each line in the output of git blame --porcelain.
+// Details of a commit are only printed for the first line attributed to it.
+func parseBlame(out string) (map[int]string, error) {
+	authors := map[int]string{}
+	names := map[string]string{}
+	mails := map[string]string{}
+	var commit string
+	var line int
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	// Lines of source are printed in full, so allow lines as long as those accepted
+	// from linters.
+	scanner.Buffer(make([]byte, 0, 4096), checkers.DefaultMaxLineSize)
+	for scanner.Scan() {
+		text := scanner.Text()
+		switch {
+		case strings.HasPrefix(text, "\t"):
+			authors[line] = strings.TrimSpace(names[commit] + " " + mails[commit])
+		case strings.HasPrefix(text, "author "):
+			names[commit] = strings.TrimPrefix(text, "author ")
+		case strings.HasPrefix(text, "author-mail "):
+			mails[commit] = strings.TrimPrefix(text, "author-mail ")
+		default:
+			// Header lines are of the form <commit> <original line> <final line> [<lines>].
+			fields := strings.Fields(text)
+			if len(fields) < 3 || !isCommitHash(fields[0]) {
+				continue
+			}
+			if n, err := strconv.Atoi(fields[2]); err == nil {
+				commit, line = fields[0], n
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read git blame output: %v", err)
+	}
+	return authors, nil
+}
+
+// isCommitHash returns true if s is a full SHA-1 or SHA-256 commit hash.
+func isCommitHash(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return false
+		}
+	}
+	return true
+}
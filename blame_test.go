@@ -0,0 +1,91 @@
+package lint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestWithBlame(t *testing.T) {
+	calls := map[string]int{}
+	blame := func(file string) (map[int]string, error) {
+		calls[file]++
+		if file == "missing.go" {
+			return nil, fmt.Errorf("no such file")
+		}
+		return map[int]string{1: "Jane Doe <jane@example.com>", 2: "Sam Roe <sam@example.com>"}, nil
+	}
+	c := lint.WithBlameFunc(blame, lint.Group{lint.Stub(
+		"a.go:1:2: unchecked error",
+		"a.go:2: unused variable",
+		"a.go:9:1: no author",
+		"missing.go:1:1: no blame",
+		"missing.go:2:1: no blame",
+		"no position",
+	)})
+	expected := []string{
+		"lint.Stub: a.go:1:2: unchecked error (author: Jane Doe <jane@example.com>)",
+		"lint.Stub: a.go:2: unused variable (author: Sam Roe <sam@example.com>)",
+		"lint.Stub: a.go:9:1: no author",
+		"lint.Stub: missing.go:1:1: no blame",
+		"lint.Stub: missing.go:2:1: no blame",
+		"lint.Stub: no position",
+	}
+	err := c.Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+	assert(t, reflect.DeepEqual(calls, map[string]int{"a.go": 1, "missing.go": 1}), fmt.Sprintf("%v", calls))
+	assert(t, lint.WithBlameFunc(blame, lint.Stub()).Check() == nil, "expected no error")
+}
+
+func TestGitBlame(t *testing.T) {
+	// Repositories may use SHA-256 object names, and lines may be too long for a
+	// bufio.Scanner with the default buffer.
+	long := "var y = \"" + strings.Repeat("y", 100*1024) + "\"\n"
+	for _, format := range []string{"sha1", "sha256"} {
+		testGitBlame(t, format, long)
+	}
+}
+
+func testGitBlame(t *testing.T, format, long string) {
+	dir, err := ioutil.TempDir("", "blame")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	git := func(name, email string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=" + name, "-c", "user.email=" + email}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+	file := filepath.Join(dir, "a.go")
+	git("", "", "init", "-q", "--object-format="+format)
+	if err = ioutil.WriteFile(file, []byte("package a\n\nvar x = 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("Jane Doe", "jane@example.com", "add", "a.go")
+	git("Jane Doe", "jane@example.com", "commit", "-q", "-m", "first")
+	if err = ioutil.WriteFile(file, []byte("package a\n\nvar x = 2\n"+long), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("Sam Roe", "sam@example.com", "commit", "-q", "-a", "-m", "second")
+
+	authors, err := lint.GitBlame(file)
+	expected := map[int]string{
+		1: "Jane Doe <jane@example.com>",
+		2: "Jane Doe <jane@example.com>",
+		3: "Sam Roe <sam@example.com>",
+		4: "Sam Roe <sam@example.com>",
+	}
+	assert(t, err == nil && reflect.DeepEqual(authors, expected), fmt.Sprintf("%s: %v %v", format, authors, err))
+	_, err = lint.GitBlame(filepath.Join(dir, "missing.go"))
+	assert(t, err != nil, "expected an error for a missing file")
+}
@@ -0,0 +1,70 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type fileBudget struct {
+	wrapped
+	maxPercent float64
+	totalFiles int
+	w          io.Writer
+}
+
+// FileBudget returns a Checker that fails only if more than maxPercent percent of
+// totalFiles have findings reported by c. This allows lint checks to be enforced
+// gradually on large code bases.
+//
+// When the budget is not exceeded, findings are written to w, or os.Stderr if w is
+// nil, and nil is returned. Findings without file information cannot be attributed
+// to a file and always result in an error, which holds all findings. Operational
+// errors returned by c are always returned.
+func FileBudget(maxPercent float64, totalFiles int, w io.Writer, c Checker) Checker {
+	if w == nil {
+		w = os.Stderr
+	}
+	return fileBudget{maxPercent: maxPercent, totalFiles: totalFiles, w: w, wrapped: wrapped{c}}
+}
+
+func (b fileBudget) Check(pkgs ...string) error {
+	if b.totalFiles <= 0 {
+		return checkers.Operational(fmt.Errorf("invalid file count for budget: %d", b.totalFiles))
+	}
+	err := b.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	errs := findings(found)
+	files := map[string]bool{}
+	var unattributed []string
+	for _, e := range errs {
+		f := findingFile(e)
+		if f == "" {
+			unattributed = append(unattributed, e)
+			continue
+		}
+		if abs, err := filepath.Abs(f); err == nil {
+			f = abs
+		}
+		files[f] = true
+	}
+	if len(unattributed) > 0 {
+		return withOps(errs, ops)
+	}
+	percent := 100 * float64(len(files)) / float64(b.totalFiles)
+	if percent > b.maxPercent {
+		return withOps(append(errs,
+			fmt.Sprintf("%d of %d files (%.2f%%) have findings, exceeding the budget of %.2f%%",
+				len(files), b.totalFiles, percent, b.maxPercent)), ops)
+	}
+	for _, e := range errs {
+		fmt.Fprintln(b.w, e)
+	}
+	return withOps(nil, ops)
+}
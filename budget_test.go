@@ -0,0 +1,56 @@
+package lint_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+// threeFiles reports four findings across three files.
+var threeFiles = checkFn(func(...string) error {
+	return checkers.Error(
+		"a.go:1:1: first",
+		"a.go:2:1: second",
+		"b.go:3:1: third",
+		"c.go:4: fourth",
+	)
+})
+
+func TestFileBudget(t *testing.T) {
+	// 3 of 100 files is within a budget of 5%
+	var out bytes.Buffer
+	err := lint.FileBudget(5, 100, &out, threeFiles).Check("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, strings.Count(out.String(), "\n") == 4 && strings.Contains(out.String(), "b.go:3:1: third"), out.String())
+
+	// 3 of 100 files exceeds a budget of 2%
+	out.Reset()
+	err = lint.FileBudget(2, 100, &out, threeFiles).Check("./...")
+	assert(t, err != nil, "expected budget to be exceeded")
+	assert(t, strings.Contains(err.Error(), "a.go:1:1: first"), err.Error())
+	assert(t, strings.HasSuffix(err.Error(), "3 of 100 files (3.00%) have findings, exceeding the budget of 2.00%"), err.Error())
+	assert(t, out.Len() == 0, out.String())
+
+	// Exactly at the budget is allowed
+	err = lint.FileBudget(3, 100, &out, threeFiles).Check("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+
+	// Errors without positions always fail, along with all other findings
+	out.Reset()
+	mixed := checkFn(func(...string) error { return checkers.Error("a.go:1:1: first", "no position") })
+	err = lint.FileBudget(50, 100, &out, mixed).Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), []string{"a.go:1:1: first", "no position"}), fmt.Sprintf("%v", err))
+	assert(t, out.Len() == 0, out.String())
+
+	err = lint.FileBudget(50, 100, &out, ungroupedError).Check("./...")
+	assert(t, err != nil && err.Error() == "ungrouped: 1", fmt.Sprintf("%v", err))
+
+	err = lint.FileBudget(50, 0, &out, threeFiles).Check("./...")
+	_, ok := err.(checkers.OperationalError)
+	assert(t, ok && strings.Contains(err.Error(), "invalid file count"), fmt.Sprintf("%v", err))
+}
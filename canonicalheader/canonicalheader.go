@@ -0,0 +1,20 @@
+// Package canonicalheader provides lint integration for the canonicalheader linter
+package canonicalheader
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the canonicalheader linter (https://github.com/lasiar/canonicalheader)
+type Check struct {
+	// Command sets the environment and working directory used to run canonicalheader
+	checkers.Command
+}
+
+// Check runs canonicalheader and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "canonicalheader", "", "github.com/lasiar/canonicalheader/cmd/canonicalheader", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for canonicalheader
+func (c Check) Args() []string {
+	return nil
+}
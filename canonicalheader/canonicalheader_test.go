@@ -0,0 +1,59 @@
+package canonicalheader_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/canonicalheader"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestCanonicalheader(t *testing.T) {
+	testutil.Test(t, "canonicalheadertest", []testutil.StaticCheckTest{
+		{
+			Checker: canonicalheader.Check{},
+			Content: []byte(`package canonicalheadertest
+
+import "net/http"
+
+// ContentType is a test function
+func ContentType(header http.Header) string {
+	return header.Get("Content-Type")
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: canonicalheader.Check{},
+			Content: []byte(`package canonicalheadertest
+
+import "net/http"
+
+// ContentType is a test function
+func ContentType(header http.Header) string {
+	return header.Get("content-type")
+}
+`),
+			Validate: testutil.Contains(`non-canonical header "content-type", instead use: "Content-Type"`),
+		},
+		{
+			Checker: canonicalheader.Check{},
+			Content: []byte(`package canonicalheadertest
+
+import "net/http"
+
+// ContentType is a test function
+func ContentType(header http.Header) string {
+	return header.Get("content-type")
+}
+`),
+			Validate: testutil.SkippedErrors(`non-canonical header`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: canonicalheader.Check{}, Expected: nil},
+	})
+}
@@ -0,0 +1,86 @@
+package lint
+
+// Categories used to group findings in a Report.
+const (
+	CategoryStyle         = "style"
+	CategoryCorrectness   = "correctness"
+	CategoryPerformance   = "performance"
+	CategorySecurity      = "security"
+	CategoryUncategorized = "uncategorized"
+)
+
+// Categorized is implemented by checkers that report findings of a single category,
+// such as CategoryStyle.
+type Categorized interface {
+	Category() string
+}
+
+// categories holds the default categories of the checkers in this repository, keyed
+// by the name used by Group.
+var categories = map[string]string{
+	"aligncheck.Check":                CategoryPerformance,
+	"canonicalheader.Check":           CategoryCorrectness,
+	"containedctx.Check":              CategoryStyle,
+	"cyclop.Check":                    CategoryStyle,
+	"decorder.Check":                  CategoryStyle,
+	"dupl.Check":                      CategoryStyle,
+	"errcheck.Check":                  CategoryCorrectness,
+	"exhaustruct.Check":               CategoryCorrectness,
+	"exportloopref.Check":             CategoryCorrectness,
+	"gci.Check":                       CategoryStyle,
+	"gocheckcompilerdirectives.Check": CategoryCorrectness,
+	"gochecknoglobals.Check":          CategoryStyle,
+	"gochecksumtype.Check":            CategoryCorrectness,
+	"gocognit.Check":                  CategoryStyle,
+	"gofmt.Check":                     CategoryStyle,
+	"golint.Check":                    CategoryStyle,
+	"gomoddirectives.Check":           CategoryStyle,
+	"gomodtidy.Check":                 CategoryStyle,
+	"gosec.Check":                     CategorySecurity,
+	"gosimple.Check":                  CategoryStyle,
+	"gosmopolitan.Check":              CategoryStyle,
+	"gostaticcheck.Check":             CategoryCorrectness,
+	"govet.Check":                     CategoryCorrectness,
+	"inamedparam.Check":               CategoryStyle,
+	"interfacebloat.Check":            CategoryStyle,
+	"intrange.Check":                  CategoryStyle,
+	"ireturn.Check":                   CategoryStyle,
+	"loggercheck.Check":               CategoryCorrectness,
+	"maintidx.Check":                  CategoryStyle,
+	"makezero.Check":                  CategoryCorrectness,
+	"mnd.Check":                       CategoryStyle,
+	"musttag.Check":                   CategoryCorrectness,
+	"nlreturn.Check":                  CategoryStyle,
+	"nonamedreturns.Check":            CategoryStyle,
+	"nosprintfhostport.Check":         CategoryCorrectness,
+	"perfsprint.Check":                CategoryPerformance,
+	"protogetter.Check":               CategoryCorrectness,
+	"reassign.Check":                  CategoryCorrectness,
+	"recvcheck.Check":                 CategoryStyle,
+	"rowserrcheck.Check":              CategoryCorrectness,
+	"sloglint.Check":                  CategoryStyle,
+	"spancheck.Check":                 CategoryCorrectness,
+	"sqlclosecheck.Check":             CategoryCorrectness,
+	"structcheck.Check":               CategoryCorrectness,
+	"tagliatelle.Check":               CategoryStyle,
+	"tenv.Check":                      CategoryCorrectness,
+	"testconsistency.Check":           CategoryStyle,
+	"unparam.Check":                   CategoryStyle,
+	"varcheck.Check":                  CategoryCorrectness,
+	"varnamelen.Check":                CategoryStyle,
+	"wastedassign.Check":              CategoryStyle,
+	"zerologlint.Check":               CategoryCorrectness,
+}
+
+// CategoryOf returns the category of findings reported by c. If c implements
+// Categorized its category is used, otherwise the default for built in checkers.
+// CategoryUncategorized is returned for all other checkers.
+func CategoryOf(c Checker) string {
+	if cat, ok := c.(Categorized); ok {
+		return cat.Category()
+	}
+	if cat, ok := categories[checkerName(c)]; ok {
+		return cat
+	}
+	return CategoryUncategorized
+}
@@ -0,0 +1,90 @@
+package lint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/errcheck"
+	"github.com/surullabs/lint/gofmt"
+)
+
+type categorized struct {
+	lint.StubChecker
+	category string
+}
+
+func (c categorized) Category() string { return c.category }
+
+func TestCategoryOf(t *testing.T) {
+	tests := []struct {
+		c        lint.Checker
+		expected string
+	}{
+		{gofmt.Check{}, lint.CategoryStyle},
+		{errcheck.Check{}, lint.CategoryCorrectness},
+		{lint.Stub().WithName("gosec.Check"), lint.CategorySecurity},
+		{lint.Stub(), lint.CategoryUncategorized},
+		{twoErrors, lint.CategoryUncategorized},
+		{categorized{lint.Stub().WithName("gofmt.Check"), lint.CategoryPerformance}, lint.CategoryPerformance},
+	}
+	for i, test := range tests {
+		cat := lint.CategoryOf(test.c)
+		assert(t, cat == test.expected, fmt.Sprintf("%d: expected %s, got %s", i, test.expected, cat))
+	}
+}
+
+func TestReportByCategory(t *testing.T) {
+	report, err := lint.Group{
+		lint.Stub("a.go:1: not formatted").WithName("gofmt.Check"),
+		lint.Stub("a.go:2: unchecked", "a.go:3: unchecked").WithName("errcheck.Check"),
+		categorized{lint.Stub("a.go:4: injection"), lint.CategorySecurity},
+		lint.Stub().WithName("golint.Check"),
+		twoErrors,
+	}.Report("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	expected := map[string]int{
+		lint.CategoryStyle:         1,
+		lint.CategoryCorrectness:   2,
+		lint.CategorySecurity:      1,
+		lint.CategoryUncategorized: 2,
+	}
+	assert(t, reflect.DeepEqual(report.ByCategory, expected), fmt.Sprintf("%v", report.ByCategory))
+	assert(t, report.Results[2].Category == lint.CategorySecurity, fmt.Sprintf("%v", report.Results[2]))
+}
+
+func TestWrappersKeepIdentity(t *testing.T) {
+	c := lint.Heavy(3, categorized{lint.Stub().WithName("gosec.Check"), lint.CategoryPerformance})
+	wrappers := []lint.Checker{
+		c,
+		lint.Advisory(ioutil.Discard, c),
+		lint.WithBlame(c),
+		lint.FileBudget(50, 10, ioutil.Discard, c),
+		lint.WithDocsURL(func(string) string { return "" }, c),
+		lint.ExamplesOnly(c),
+		lint.ImportingOnly("example.com/p", c),
+		lint.Isolated(c),
+		lint.NoWorse("lint.state", c),
+		lint.PipeTo("cat", nil, c),
+		lint.ForPlatforms(nil, c),
+		lint.Rewrite(regexp.MustCompile("x"), "y", c),
+		lint.Classify(func(string) lint.Severity { return lint.SeverityUnknown }, c),
+		lint.StrictSkip(lint.RegexpMatch("x"), c),
+		lint.WithSource(1, c),
+		lint.StagedChecker(c),
+		lint.RestrictToFiles(nil, c),
+		lint.MultiTags(nil, c),
+		lint.Tee("lint.json", nil, c),
+		lint.Pipe(c),
+		lint.Deterministic(c),
+	}
+	for i, w := range wrappers {
+		named, ok := w.(lint.Named)
+		assert(t, ok && named.Name() == "gosec.Check", fmt.Sprintf("%d: expected gosec.Check, got %T", i, w))
+		assert(t, lint.CategoryOf(w) == lint.CategoryPerformance, fmt.Sprintf("%d: %s", i, lint.CategoryOf(w)))
+		assert(t, lint.WeightOf(w) == 3, fmt.Sprintf("%d: %d", i, lint.WeightOf(w)))
+	}
+}
@@ -0,0 +1,59 @@
+package lint
+
+import (
+	"fmt"
+	"go/build"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint/checkers"
+)
+
+// checkBytesPkg is the import path of the temporary package used by CheckBytes.
+const checkBytesPkg = "lintcheckbytes"
+
+// gopathMu is held while GOPATH is changed to include a temporary directory, so
+// that checks using a temporary GOPATH do not overwrite each other's changes.
+var gopathMu sync.Mutex
+
+// CheckBytes runs c on a temporary package holding a single file with content,
+// as done by testutil.Test. References to the temporary file in the returned
+// findings are replaced by filename, so that findings can be reported for files
+// which are not in a GOPATH, such as unsaved files in an editor.
+//
+// CheckBytes adds a temporary directory to GOPATH while it runs and restores it
+// before returning. Calls to CheckBytes are serialized, but it must not be called
+// concurrently with other checks, such as from a parallel test or a Concurrent
+// group, as they would see the modified GOPATH.
+func CheckBytes(filename string, content []byte, c Checker) error {
+	gopathMu.Lock()
+	defer gopathMu.Unlock()
+	checkers.Unload(checkBytesPkg)
+	defer checkers.Unload(checkBytesPkg)
+	name := filepath.Base(filename)
+	tmp, err := fakegopath.NewTemporaryWithFiles(checkBytesPkg, []fakegopath.SourceFile{
+		{Content: content, Dest: filepath.Join(checkBytesPkg, name)},
+	})
+	if tmp != nil {
+		defer tmp.Reset()
+	}
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to create temporary go path: %v", err))
+	}
+	p, err := build.Import(checkBytesPkg, "", build.FindOnly)
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to find temporary package: %v", err))
+	}
+	paths := []string{filepath.Join(p.Dir, name)}
+	if resolved, err := filepath.EvalSymlinks(paths[0]); err == nil && resolved != paths[0] {
+		paths = append(paths, resolved)
+	}
+	return mapFindings(c.Check(checkBytesPkg), func(finding string) string {
+		for _, path := range paths {
+			finding = strings.Replace(finding, path, filename, -1)
+		}
+		return finding
+	})
+}
@@ -0,0 +1,30 @@
+package lint_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/errcheck"
+	"github.com/surullabs/lint/gofmt"
+)
+
+func TestCheckBytes(t *testing.T) {
+	err := lint.CheckBytes("cmd/server/main.go", []byte(`package main
+
+import "os"
+
+func main() {
+	os.Remove("somefile")
+}
+`), errcheck.Check{})
+	assert(t, err != nil && strings.HasPrefix(err.Error(), "cmd/server/main.go:6:"), fmt.Sprintf("%v", err))
+
+	err = lint.CheckBytes("cmd/server/main.go", []byte("package main\n\nfunc main() {\n  println()\n}\n"), gofmt.Check{})
+	assert(t, err != nil && strings.Contains(err.Error(), "cmd/server/main.go"), fmt.Sprintf("%v", err))
+	assert(t, !strings.Contains(err.Error(), "lintcheckbytes"), err.Error())
+
+	err = lint.CheckBytes("main.go", []byte("package main\n"), gofmt.Check{})
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+}
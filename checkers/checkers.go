@@ -2,11 +2,16 @@
 package checkers
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
 	"go/build"
+	"io"
 	"io/ioutil"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"syscall"
 
@@ -37,12 +42,25 @@ type errorList []string
 func (e errorList) Errors() []string { return []string(e) }
 func (e errorList) Error() string    { return strings.Join(e, "\n") }
 
-func packageDir(path string) (string, error) {
-	pkg, err := build.Import(path, ".", build.FindOnly)
-	if err != nil {
-		return "", fmt.Errorf("import failed: %s: %v", path, err)
+// OperationalError is an error which prevented a linter from running, such as a
+// missing binary or a package that could not be loaded. It is distinct from
+// issues reported by a linter.
+type OperationalError struct {
+	Err error
+}
+
+func (e OperationalError) Error() string { return e.Err.Error() }
+
+// Operational returns err as an OperationalError. If err is nil, nil is returned.
+func Operational(err error) error {
+	switch err.(type) {
+	case nil:
+		return nil
+	case OperationalError:
+		return err
+	default:
+		return OperationalError{Err: err}
 	}
-	return pkg.Dir, nil
 }
 
 // FindBin returns bin if it exists in the path. If not it checks
@@ -64,21 +82,22 @@ func FindBin(bin string) (string, error) {
 
 // InstallMissing runs go get getPath and then go get importPath
 // if bin cannot be found in the directories contained in the PATH environment variable.
-// It returns the path to the installed binary on success.
+// It returns the path to the installed binary on success and an OperationalError
+// on failure.
 func InstallMissing(bin, getPath, importPath string) (string, error) {
 	if b, err := FindBin(bin); err == nil {
 		return b, nil
 	}
 	if data, err := exec.Command("go", "get", getPath).CombinedOutput(); err != nil {
-		return "", fmt.Errorf("failed to get %s: %v: %s", importPath, err, string(data))
+		return "", Operational(fmt.Errorf("failed to get %s: %v: %s", importPath, err, string(data)))
 	}
 
 	if data, err := exec.Command("go", "install", importPath).CombinedOutput(); err != nil {
-		return "", fmt.Errorf("failed to install %s: %v: %s", importPath, err, string(data))
+		return "", Operational(fmt.Errorf("failed to install %s: %v: %s", importPath, err, string(data)))
 	}
 	b, err := FindBin(bin)
 	if err != nil {
-		return "", fmt.Errorf("failed to lookup %v after install: %v", bin, err)
+		return "", Operational(fmt.Errorf("failed to lookup %v after install: %v", bin, err))
 	}
 	return b, nil
 }
@@ -97,6 +116,49 @@ func (e *ExecErrors) Add(r ExecResult) {
 	*e = append(*e, strings.Split(str, "\n")...)
 }
 
+// SortedKeys returns the keys of m in sorted order. Checkers use it to generate
+// arguments from maps in a deterministic order.
+func SortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Command holds settings for running the external tool used by a checker. It is
+// embedded in checkers which run external tools.
+type Command struct {
+	// Env holds additional environment variables of the form key=value, such as
+	// CGO_ENABLED=0 or GOFLAGS=-mod=vendor.
+	Env []string
+	// WorkDir is the directory the tool is run in. The current directory is used
+	// if it is empty.
+	WorkDir string
+	// MaxFindings limits the number of findings retained. Further findings are
+	// counted and reported using a single "... (N more findings truncated)" line.
+	// There is no limit if it is 0.
+	MaxFindings int
+	// MaxLineSize is the length of the longest line of output accepted from the
+	// tool. It defaults to DefaultMaxLineSize if it is 0.
+	MaxLineSize int
+}
+
+// DefaultMaxLineSize is the default length of the longest line of output
+// accepted from a tool run using LintCommand.
+const DefaultMaxLineSize = 1024 * 1024
+
+// Cmd returns a command running name with args, using the settings in c.
+func Cmd(c Command, name string, args ...string) *exec.Cmd {
+	cmd := exec.Command(name, args...)
+	if len(c.Env) > 0 {
+		cmd.Env = append(os.Environ(), c.Env...)
+	}
+	cmd.Dir = c.WorkDir
+	return cmd
+}
+
 // Lint runs the linter specified by bin for each package in pkgs.
 // The linter is installed if necessary using
 //   go get getPath
@@ -104,6 +166,13 @@ func (e *ExecErrors) Add(r ExecResult) {
 //
 // If getPath is empty, installPath is used for go get.
 func Lint(bin, getPath, installPath string, pkgs []string, args ...string) error {
+	return LintCommand(Command{}, bin, getPath, installPath, pkgs, args...)
+}
+
+// LintCommand is like Lint, but runs the linter using the settings in c. Packages
+// are passed to the linter as provided, so relative paths are resolved relative
+// to c.WorkDir.
+func LintCommand(c Command, bin, getPath, installPath string, pkgs []string, args ...string) error {
 	if getPath == "" {
 		getPath = installPath
 	}
@@ -111,16 +180,189 @@ func Lint(bin, getPath, installPath string, pkgs []string, args ...string) error
 	if err != nil {
 		return err
 	}
-	errs := &ExecErrors{}
+	var errs []string
+	truncated := 0
 	for _, pkg := range pkgs {
-		p, perr := Load(pkg)
+		p, perr := LoadDir(c.WorkDir, pkg)
 		if perr != nil {
-			return fmt.Errorf("failed to load pkg info: %s: %v", pkg, perr)
+			return Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, perr))
+		}
+		var out output
+		if c.MaxFindings > 0 {
+			if out.limit = c.MaxFindings - len(errs); out.limit <= 0 {
+				out.limit = -1
+			}
+		}
+		if ferr := streamLines(c, Cmd(c, b, append(args, p.Path)...), &out); ferr != nil {
+			return ferr
+		}
+		found, ferr := out.findings()
+		if ferr != nil {
+			return ferr
 		}
-		result, _ := Exec(exec.Command(b, append(args, p.Path)...))
-		errs.Add(result)
+		errs = append(errs, found...)
+		truncated += out.truncated
 	}
-	return Error((*errs)...)
+	if truncated > 0 {
+		errs = append(errs, fmt.Sprintf("... (%d more findings truncated)", truncated))
+	}
+	return Error(errs...)
+}
+
+// streamLines runs cmd and adds each line it prints to out. Standard output is read
+// a line at a time, so that the output of the tool is not retained in full.
+func streamLines(c Command, cmd *exec.Cmd, out *output) error {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return Operational(fmt.Errorf("cmd.StdoutPipe failed: %v", err))
+	}
+	if err = cmd.Start(); err != nil {
+		return Operational(fmt.Errorf("cmd.Start failed: %v", err))
+	}
+	size := c.MaxLineSize
+	if size <= 0 {
+		size = DefaultMaxLineSize
+	}
+	initial := 4096
+	if size < initial {
+		initial = size
+	}
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, initial), size)
+	for scanner.Scan() {
+		out.add(scanner.Text())
+	}
+	if err = scanner.Err(); err != nil {
+		// Drain the remaining output so that the tool can exit.
+		if _, cerr := io.Copy(ioutil.Discard, stdout); cerr != nil {
+			err = fmt.Errorf("%v, and failed to discard remaining output: %v", err, cerr)
+		}
+		if werr := wait(cmd); werr != nil {
+			err = fmt.Errorf("%v, and %v", err, werr)
+		}
+		return Operational(fmt.Errorf("failed to read output of %s: %v", cmd.Path, err))
+	}
+	if err = wait(cmd); err != nil {
+		return Operational(err)
+	}
+	if st, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok {
+		out.code = st.ExitStatus()
+	}
+	for _, line := range strings.Split(stderr.String(), "\n") {
+		out.add(line)
+	}
+	return nil
+}
+
+// wait waits for cmd to exit. Exiting with a non zero status is not an error, as
+// linters use it to indicate findings, but failing to wait for cmd or to copy its
+// output is.
+func wait(cmd *exec.Cmd) error {
+	err := cmd.Wait()
+	if _, ok := err.(*exec.ExitError); ok || err == nil {
+		return nil
+	}
+	return fmt.Errorf("failed to wait for %s: %v", cmd.Path, err)
+}
+
+// positionRE matches lines starting with a file:line position.
+var positionRE = regexp.MustCompile(`^(?:[^:]|:[^\s0-9])+?:[0-9]+`)
+
+// OutputLines returns the findings in output printed by a linter, one per line.
+// Blank lines and lines starting with #, which are package headers as printed by
+// go vet, are ignored.
+func OutputLines(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// CombineOutput returns the findings printed by a linter to either stdout or stderr,
+// as split by OutputLines.
+//
+// If the linter exited with a non zero status without reporting any finding with a
+// position, its output is assumed to describe a failure to run, such as a package
+// which does not build, and is returned as an OperationalError. Otherwise lines
+// without a position are reported as findings too.
+func CombineOutput(r ExecResult) ([]string, error) {
+	out := output{code: r.Code}
+	for _, line := range strings.Split(r.Stdout+"\n"+r.Stderr, "\n") {
+		out.add(line)
+	}
+	return out.findings()
+}
+
+// output collects the lines printed by a linter, as done by OutputLines.
+type output struct {
+	// limit is the number of lines retained. Further lines are only counted in
+	// truncated. There is no limit if it is 0, and no lines are retained if it
+	// is negative.
+	limit     int
+	code      int
+	lines     []string
+	positions int
+	truncated int
+}
+
+func (o *output) add(line string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return
+	}
+	if positionRE.MatchString(line) {
+		o.positions++
+	}
+	if o.limit < 0 || o.limit > 0 && len(o.lines) >= o.limit {
+		o.truncated++
+		return
+	}
+	o.lines = append(o.lines, line)
+}
+
+// findings returns the lines collected, or an OperationalError as described in
+// CombineOutput.
+func (o *output) findings() ([]string, error) {
+	if o.positions == 0 && len(o.lines) > 0 && o.code != 0 {
+		return nil, Operational(fmt.Errorf("exit status %d: %s", o.code, strings.Join(o.lines, "\n")))
+	}
+	return o.lines, nil
+}
+
+// WriteFile atomically replaces the contents of the file at path with data. The data
+// is written to a temporary file in the same directory which is then renamed to path.
+// The permissions of the existing file are retained.
+func WriteFile(path string, data []byte) error {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %v", path, err)
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for %s: %v", path, err)
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), stat.Mode())
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	return nil
 }
 
 // ExecResult holds a status code, stdout and stderr for a single command execution.
@@ -161,13 +403,14 @@ func Exec(cmd *exec.Cmd) (ExecResult, error) {
 	return res, err
 }
 
-// GoFiles lists all .go files in pkgs.
+// GoFiles lists all .go files in pkgs. An OperationalError is returned if
+// a package cannot be loaded.
 func GoFiles(pkgs ...string) ([]string, error) {
 	var files []string
 	for _, pkg := range pkgs {
 		p, err := Load(pkg)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load go files for %s: %v", pkg, err)
+			return nil, Operational(fmt.Errorf("failed to load go files for %s: %v", pkg, err))
 		}
 		files = append(files, p.GoFiles...)
 	}
@@ -197,8 +440,13 @@ type Package struct {
 	GoFiles []string
 	// All sub packages if Path is a wildcard, or just Path if not.
 	Pkgs []string
+	// The directory of each package in Pkgs.
+	Dirs []string
 	// build.Package instance for this package
 	Build *build.Package
+	// srcDir is the directory relative paths are resolved against. The current
+	// directory is used if it is empty.
+	srcDir string
 }
 
 var (
@@ -216,17 +464,33 @@ func Unload(pkg string) {
 // Load returns a cached Package instance if one exists or creates a new instance if not.
 // It returns an error if there was an error reading package information.
 func Load(pkg string) (*Package, error) {
+	return LoadDir("", pkg)
+}
+
+// LoadDir is like Load, but resolves relative package paths, such as ./sub, against
+// dir instead of the current directory. Load is used if dir is empty.
+func LoadDir(dir, pkg string) (*Package, error) {
+	key := pkg
+	if dir != "" && build.IsLocalImport(pkg) {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s: %v", dir, err)
+		}
+		dir, key = abs, filepath.Join(abs, pkg)
+	} else {
+		dir = ""
+	}
 	packageMutex.Lock()
 	defer packageMutex.Unlock()
-	p := packages[pkg]
+	p := packages[key]
 	if p != nil {
 		return p, nil
 	}
-	p = &Package{Path: pkg}
+	p = &Package{Path: pkg, srcDir: dir}
 	if err := p.load(); err != nil {
 		return nil, err
 	}
-	packages[pkg] = p
+	packages[key] = p
 	return p, nil
 }
 
@@ -243,11 +507,7 @@ func (p *Package) load() error {
 
 func (p *Package) readFiles() error {
 	var res []string
-	for _, pkg := range p.Pkgs {
-		dir, err := packageDir(pkg)
-		if err != nil {
-			return err
-		}
+	for _, dir := range p.Dirs {
 		entries, err := ioutil.ReadDir(dir)
 		if err != nil {
 			return fmt.Errorf("failed to list dir %s: %v", dir, err)
@@ -267,16 +527,19 @@ func (p *Package) readFiles() error {
 }
 
 func (p *Package) readPackages() error {
-	wd, err := os.Getwd()
-	if err != nil {
-		return fmt.Errorf("failed to find cwd: %v", err)
+	wd := p.srcDir
+	if wd == "" {
+		var err error
+		if wd, err = os.Getwd(); err != nil {
+			return fmt.Errorf("failed to find cwd: %v", err)
+		}
 	}
 	if filepath.Base(p.Path) != "..." {
-		var b *build.Package
-		if b, err = build.Import(p.Path, wd, build.FindOnly); err != nil {
+		b, err := build.Import(p.Path, wd, build.FindOnly)
+		if err != nil {
 			return fmt.Errorf("import failed: %s: %v", p.Path, err)
 		}
-		p.Pkgs, p.Build = []string{b.ImportPath}, b
+		p.Pkgs, p.Dirs, p.Build = []string{b.ImportPath}, []string{b.Dir}, b
 		return nil
 	}
 
@@ -287,7 +550,7 @@ func (p *Package) readPackages() error {
 	}
 	p.Build = b
 	dir := b.Dir
-	var paths []string
+	var paths, dirs []string
 	err = filepath.Walk(dir, func(path string, stat os.FileInfo, walkErr error) error {
 		if walkErr != nil {
 			return walkErr
@@ -305,12 +568,26 @@ func (p *Package) readPackages() error {
 			}
 			return fmt.Errorf("import failed: %s: %v", path, perr)
 		}
-		paths = append(paths, p.ImportPath)
+		paths, dirs = append(paths, p.ImportPath), append(dirs, path)
 		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed to list %s: %v", dir, err)
 	}
-	p.Pkgs = paths
+	p.Pkgs, p.Dirs = paths, dirs
 	return nil
 }
+
+// ModuleRoot returns the closest directory containing a go.mod file, starting at dir.
+func ModuleRoot(dir string) (string, error) {
+	for d := dir; ; {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d, nil
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return "", fmt.Errorf("no go.mod found for %s", dir)
+		}
+		d = parent
+	}
+}
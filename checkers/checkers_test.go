@@ -0,0 +1,196 @@
+package checkers
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// stub runs a shell script standing in for a linter.
+func stub(t *testing.T, script string) ExecResult {
+	res, _ := Exec(exec.Command("sh", "-c", script))
+	if res.Code == -1 {
+		t.Fatalf("failed to run stub tool: %s", script)
+	}
+	return res
+}
+
+func TestCombineOutput(t *testing.T) {
+	tests := []struct {
+		script      string
+		findings    []string
+		operational bool
+	}{
+		{script: "exit 0"},
+		{
+			script:   "echo 'a.go:1:2: stdout finding'; echo 'a.go:3: stderr finding' >&2; exit 1",
+			findings: []string{"a.go:1:2: stdout finding", "a.go:3: stderr finding"},
+		},
+		{
+			script:   "echo '# pkg' >&2; echo 'a.go:3:1: vet finding' >&2; exit 2",
+			findings: []string{"a.go:3:1: vet finding"},
+		},
+		{
+			script:   "echo 'no position' >&2; exit 0",
+			findings: []string{"no position"},
+		},
+		{
+			script:      "echo 'cannot find package \"foo\"' >&2; exit 1",
+			operational: true,
+		},
+	}
+	for i, test := range tests {
+		found, err := CombineOutput(stub(t, test.script))
+		if _, ok := err.(OperationalError); ok != test.operational {
+			t.Errorf("%d: expected operational %v, got %v", i, test.operational, err)
+		}
+		if !reflect.DeepEqual(found, test.findings) {
+			t.Errorf("%d: expected findings %q, got %q", i, test.findings, found)
+		}
+	}
+}
+
+func TestLintCommand(t *testing.T) {
+	bin, err := ioutil.TempDir("", "lintcommand")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(bin)
+	script := "#!/bin/sh\necho \"$1:1: CGO_ENABLED=$CGO_ENABLED dir=$(pwd)\"\n"
+	if err = ioutil.WriteFile(filepath.Join(bin, "envtool"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", bin+string(os.PathListSeparator)+path)
+
+	dir, err := filepath.EvalSymlinks(bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Command{Env: []string{"CGO_ENABLED=0"}, WorkDir: dir}
+	err = LintCommand(c, "envtool", "", "example.com/envtool", []string{"."})
+	expected := ".:1: CGO_ENABLED=0 dir=" + dir
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+
+	err = Lint("envtool", "", "example.com/envtool", []string{"."})
+	if err == nil || strings.Contains(err.Error(), "dir="+dir) {
+		t.Errorf("expected tool to run in the current directory, got %v", err)
+	}
+
+	// Relative packages are resolved against WorkDir, not the current directory.
+	if err = os.Mkdir(filepath.Join(dir, "onlyhere"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(dir, "onlyhere", "a.go")
+	if err = ioutil.WriteFile(src, []byte("package onlyhere\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = LintCommand(c, "envtool", "", "example.com/envtool", []string{"./onlyhere"})
+	expected = "./onlyhere:1: CGO_ENABLED=0 dir=" + dir
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+	p, err := LoadDir(dir, "./onlyhere")
+	if err != nil || !reflect.DeepEqual(p.GoFiles, []string{src}) {
+		t.Errorf("expected %s to be loaded, got %v, %v", src, p, err)
+	}
+	if _, err = Load("./onlyhere"); err == nil {
+		t.Error("expected ./onlyhere to be missing from the current directory")
+	}
+}
+
+func TestLintCommandFailure(t *testing.T) {
+	bin, err := ioutil.TempDir("", "failingtool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(bin)
+	script := "#!/bin/sh\necho \"no position for $1\" >&2\nexit $STATUS\n"
+	if err = ioutil.WriteFile(filepath.Join(bin, "failingtool"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", bin+string(os.PathListSeparator)+path)
+
+	// A non zero exit without any positioned finding is a failure to run.
+	err = LintCommand(Command{Env: []string{"STATUS=1"}}, "failingtool", "", "example.com/failingtool", []string{"."})
+	if _, ok := err.(OperationalError); !ok || err.Error() != "exit status 1: no position for ." {
+		t.Errorf("expected an operational error, got %v", err)
+	}
+
+	err = LintCommand(Command{Env: []string{"STATUS=0"}}, "failingtool", "", "example.com/failingtool", []string{"."})
+	if found := errorsOf(err); !reflect.DeepEqual(found, []string{"no position for ."}) {
+		t.Errorf("expected a finding, got %v", err)
+	}
+}
+
+func TestLintCommandMaxFindings(t *testing.T) {
+	bin, err := ioutil.TempDir("", "maxfindings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(bin)
+	script := "#!/bin/sh\ni=0\nwhile [ $i -lt 5000 ]; do echo \"$1/a.go:$i: finding $i\"; i=$((i+1)); done\necho \"$1/b.go:1: stderr\" >&2\n"
+	if err = ioutil.WriteFile(filepath.Join(bin, "noisytool"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", bin+string(os.PathListSeparator)+path)
+
+	err = LintCommand(Command{MaxFindings: 3}, "noisytool", "", "example.com/noisytool", []string{".", "."})
+	expected := []string{"./a.go:0: finding 0", "./a.go:1: finding 1", "./a.go:2: finding 2", "... (9999 more findings truncated)"}
+	if found := errorsOf(err); !reflect.DeepEqual(found, expected) {
+		t.Errorf("expected %q, got %q", expected, found)
+	}
+
+	err = LintCommand(Command{}, "noisytool", "", "example.com/noisytool", []string{"."})
+	if found := errorsOf(err); len(found) != 5001 || found[5000] != "./b.go:1: stderr" {
+		t.Errorf("expected all 5001 findings, got %d", len(found))
+	}
+
+	err = LintCommand(Command{MaxLineSize: 10}, "noisytool", "", "example.com/noisytool", []string{"."})
+	if _, ok := err.(OperationalError); !ok || !strings.Contains(err.Error(), "token too long") {
+		t.Errorf("expected an operational error for long lines, got %v", err)
+	}
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestWait(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "exit 1")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := wait(cmd); err != nil {
+		t.Errorf("expected a non zero exit to be ignored, got %v", err)
+	}
+
+	cmd = exec.Command("sh", "-c", "echo failed >&2")
+	cmd.Stderr = failingWriter{}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := wait(cmd); err == nil || !strings.Contains(err.Error(), "write failed") {
+		t.Errorf("expected failure to copy output, got %v", err)
+	}
+}
+
+func errorsOf(err error) []string {
+	if e, ok := err.(errorList); ok {
+		return e.Errors()
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package lint
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// FormatCheckstyle formats report as checkstyle XML. Findings are grouped by the
+// file they refer to, in the order files are first reported, and the name of the
+// checker is reported as the source of each finding. The severity of a finding is
+// error for high or unknown, warning for medium and info for low. As in
+// FormatJUnit, findings without file information are reported for a file named
+// after the checker.
+func FormatCheckstyle(report *Report) ([]byte, error) {
+	res := checkstyleReport{Version: "4.3"}
+	index := map[string]int{}
+	for _, r := range report.Results {
+		for i, f := range r.Findings {
+			e := checkstyleError{Severity: severityLevel(r, i, "error", "warning", "info"), Message: f, Source: r.Checker}
+			file := r.Checker
+			if label, path, line, col, msg, ok := parseLabeled(f); ok {
+				file, e.Line, e.Column, e.Message = path, line, col, labeled(label, msg)
+			}
+			n, ok := index[file]
+			if !ok {
+				n, index[file] = len(res.Files), len(res.Files)
+				res.Files = append(res.Files, checkstyleFile{Name: file})
+			}
+			res.Files[n].Errors = append(res.Files[n].Errors, e)
+		}
+	}
+	data, err := xml.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to format checkstyle xml: %v", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,68 @@
+package lint_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestFormatCheckstyle(t *testing.T) {
+	report := &lint.Report{
+		Results: []lint.Result{
+			{Checker: "errcheck.Check", Findings: []string{
+				"pkg/bad.go:3:2: f.Close()",
+				"pkg/other.go:5: <w.Write()>",
+			}},
+			{
+				Checker:    "gosec.Check",
+				Findings:   []string{"pkg/bad.go:7:2: [G104] Errors unhandled.", "exit status 1"},
+				Severities: []lint.Severity{lint.SeverityMedium, lint.SeverityUnknown},
+			},
+		},
+	}
+	data, err := lint.FormatCheckstyle(report)
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, strings.HasPrefix(string(data), xml.Header), string(data))
+
+	type checkstyleError struct {
+		Line     int    `xml:"line,attr"`
+		Column   int    `xml:"column,attr"`
+		Severity string `xml:"severity,attr"`
+		Message  string `xml:"message,attr"`
+		Source   string `xml:"source,attr"`
+	}
+	var parsed struct {
+		Version string `xml:"version,attr"`
+		Files   []struct {
+			Name   string            `xml:"name,attr"`
+			Errors []checkstyleError `xml:"error"`
+		} `xml:"file"`
+	}
+	err = xml.Unmarshal(data, &parsed)
+	assert(t, err == nil, fmt.Sprintf("invalid xml: %v\n%s", err, data))
+	assert(t, parsed.Version == "4.3" && len(parsed.Files) == 3, string(data))
+
+	bad := parsed.Files[0]
+	assert(t, bad.Name == "pkg/bad.go", string(data))
+	assert(t, reflect.DeepEqual(bad.Errors, []checkstyleError{
+		{Line: 3, Column: 2, Severity: "error", Message: "f.Close()", Source: "errcheck.Check"},
+		{Line: 7, Column: 2, Severity: "warning", Message: "[G104] Errors unhandled.", Source: "gosec.Check"},
+	}), string(data))
+
+	other := parsed.Files[1]
+	assert(t, other.Name == "pkg/other.go", string(data))
+	assert(t, reflect.DeepEqual(other.Errors, []checkstyleError{
+		{Line: 5, Severity: "error", Message: "<w.Write()>", Source: "errcheck.Check"},
+	}), string(data))
+
+	// Findings without a file are reported for the checker.
+	unattributed := parsed.Files[2]
+	assert(t, unattributed.Name == "gosec.Check", string(data))
+	assert(t, reflect.DeepEqual(unattributed.Errors, []checkstyleError{
+		{Severity: "error", Message: "exit status 1", Source: "gosec.Check"},
+	}), string(data))
+}
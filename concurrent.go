@@ -0,0 +1,146 @@
+package lint
+
+import (
+	"runtime"
+	"sync"
+)
+
+type concurrent struct {
+	n        int
+	g        Group
+	failFast bool
+}
+
+// Concurrent returns a Checker that applies the checkers in g concurrently, running
+// at most n at a time. If n is 0 or less, runtime.GOMAXPROCS(0) is used. Errors are
+// returned as described in Group.Check, in the order of the checkers in g.
+//
+// Each checker uses one of the n slots, except for checkers implementing Weighted,
+// such as those wrapped using Heavy, which use WeightOf(checker) slots.
+func Concurrent(n int, g Group) Checker {
+	return concurrent{n: n, g: g}
+}
+
+// Weighted is implemented by checkers which use more than one slot when run by
+// Concurrent. Checkers wrapping another checker implement it by returning the
+// weight of the wrapped checker.
+type Weighted interface {
+	Weight() int
+}
+
+// WeightOf returns the number of slots c uses when run by Concurrent. This is 1
+// unless c implements Weighted and returns a larger weight.
+func WeightOf(c Checker) int {
+	if w, ok := c.(Weighted); ok && w.Weight() > 1 {
+		return w.Weight()
+	}
+	return 1
+}
+
+type heavy struct {
+	wrapped
+	weight int
+}
+
+// Heavy returns a Checker that runs c, but uses weight slots when run by Concurrent.
+// This is intended for checkers which run several processes in parallel themselves,
+// such as staticcheck or go vet, so that fewer checkers are started alongside them.
+// The weight is limited to the number of slots available.
+func Heavy(weight int, c Checker) Checker {
+	return heavy{wrapped: wrapped{c}, weight: weight}
+}
+
+// Weight returns the weight h was created with.
+func (h heavy) Weight() int { return h.weight }
+
+func (h heavy) Check(pkgs ...string) error { return h.checker.Check(pkgs...) }
+
+// slots is a counting semaphore allowing callers to acquire several slots at once.
+type slots struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+	free int
+}
+
+func newSlots(n int) *slots {
+	s := &slots{free: n}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+func (s *slots) acquire(n int) {
+	s.mu.Lock()
+	for s.free < n {
+		s.cond.Wait()
+	}
+	s.free -= n
+	s.mu.Unlock()
+}
+
+func (s *slots) release(n int) {
+	s.mu.Lock()
+	s.free += n
+	s.mu.Unlock()
+	s.cond.Broadcast()
+}
+
+func (c concurrent) Check(pkgs ...string) error {
+	n := c.n
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	available := newSlots(n)
+	var mu sync.Mutex
+	results := make([]error, len(c.g))
+	completed := make([]bool, len(c.g))
+	failed := make(chan struct{})
+	var failOnce sync.Once
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for i, checker := range c.g {
+			weight := WeightOf(checker)
+			if weight > n {
+				weight = n
+			}
+			// Slots are acquired in order so that heavy checkers are not starved.
+			available.acquire(weight)
+			select {
+			case <-failed:
+				available.release(weight)
+				wg.Wait()
+				close(done)
+				return
+			default:
+			}
+			wg.Add(1)
+			go func(i, weight int, checker Checker) {
+				defer wg.Done()
+				defer available.release(weight)
+				err := Group{checker}.Check(pkgs...)
+				mu.Lock()
+				results[i], completed[i] = err, true
+				mu.Unlock()
+				if err != nil && c.failFast {
+					failOnce.Do(func() { close(failed) })
+				}
+			}(i, weight, checker)
+		}
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-failed:
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	var res groupErrors
+	for i, err := range results {
+		if completed[i] {
+			res.add("", err)
+		}
+	}
+	return res.err()
+}
@@ -0,0 +1,81 @@
+package lint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/surullabs/lint"
+)
+
+// running tracks the number of checkers running at the same time.
+type running struct {
+	mu       sync.Mutex
+	now, max int
+}
+
+func (r *running) checker(d time.Duration) lint.Checker {
+	return checkFn(func(...string) error {
+		r.mu.Lock()
+		r.now++
+		if r.now > r.max {
+			r.max = r.now
+		}
+		r.mu.Unlock()
+		time.Sleep(d)
+		r.mu.Lock()
+		r.now--
+		r.mu.Unlock()
+		return nil
+	})
+}
+
+func TestConcurrent(t *testing.T) {
+	err := lint.Concurrent(2, lint.Group{
+		slow(20*time.Millisecond, twoErrors),
+		expectRecursive,
+		ungroupedError,
+	}).Check("./...")
+	expected := []string{"lint_test.checkFn: err1", "lint_test.checkFn: err2", "lint_test.checkFn: ungrouped: 1"}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%v", err))
+	assert(t, lint.Concurrent(0, lint.Group{expectRecursive}).Check("./...") == nil, "expected no error")
+
+	r := &running{}
+	var g lint.Group
+	for i := 0; i < 8; i++ {
+		g = append(g, r.checker(20*time.Millisecond))
+	}
+	assert(t, lint.Concurrent(4, g).Check() == nil, "expected no error")
+	assert(t, r.max > 1 && r.max <= 4, fmt.Sprintf("expected at most 4 concurrent checkers, got %d", r.max))
+}
+
+func TestHeavy(t *testing.T) {
+	r := &running{}
+	g := lint.Group{lint.Heavy(3, r.checker(50*time.Millisecond))}
+	for i := 0; i < 4; i++ {
+		g = append(g, r.checker(20*time.Millisecond))
+	}
+	assert(t, lint.Concurrent(4, g).Check() == nil, "expected no error")
+	assert(t, r.max == 2, fmt.Sprintf("expected a single light checker alongside the heavy checker, got %d", r.max))
+
+	// The weight is limited to the available slots.
+	r = &running{}
+	assert(t, lint.Concurrent(2, lint.Group{lint.Heavy(5, r.checker(time.Millisecond))}).Check() == nil, "expected no error")
+	assert(t, r.max == 1, fmt.Sprintf("%d", r.max))
+
+	err := lint.Group{lint.Heavy(2, twoErrors)}.Check()
+	assert(t, err.Error() == "lint_test.checkFn: err1\nlint_test.checkFn: err2", err.Error())
+
+	// Wrappers keep the weight of the checker they wrap.
+	r = &running{}
+	g = lint.Group{lint.Advisory(ioutil.Discard, lint.StrictSkip(lint.RegexpMatch("x"), lint.Heavy(3, r.checker(50*time.Millisecond))))}
+	for i := 0; i < 4; i++ {
+		g = append(g, r.checker(20*time.Millisecond))
+	}
+	assert(t, lint.WeightOf(g[0]) == 3 && lint.WeightOf(g[1]) == 1, fmt.Sprintf("%d", lint.WeightOf(g[0])))
+	assert(t, lint.Concurrent(4, g).Check() == nil, "expected no error")
+	assert(t, r.max == 2, fmt.Sprintf("expected a single light checker alongside the wrapped heavy checker, got %d", r.max))
+}
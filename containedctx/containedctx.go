@@ -0,0 +1,79 @@
+// Package containedctx provides lint integration for the containedctx linter
+package containedctx
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the containedctx linter (https://github.com/sivchari/containedctx)
+type Check struct {
+	// Command sets the environment and working directory used to run containedctx
+	checkers.Command
+}
+
+// Check runs containedctx and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "containedctx", "", "github.com/sivchari/containedctx/cmd/containedctx", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for containedctx
+func (c Check) Args() []string {
+	return nil
+}
+
+// Skip returns a Skipper which ignores findings for structs named any of names.
+// The struct is found by parsing the file referenced by the finding.
+//
+//    err = lint.Skip(err, containedctx.Skip("Server"))
+func Skip(names ...string) lint.Skipper {
+	return structNames(names)
+}
+
+// structNames skips findings for structs with the given names.
+type structNames []string
+
+func (s structNames) Skip(finding string) bool {
+	// Findings from a Group are prefixed by the checker name.
+	if _, rest, ok := lint.CheckerOf(finding); ok {
+		finding = rest
+	}
+	file, line, _, _, ok := lint.ParseFinding(finding)
+	if !ok {
+		return false
+	}
+	name := enclosingStruct(file, line)
+	for _, n := range s {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// enclosingStruct returns the name of the struct type declared in file which
+// contains line, or an empty string if there is none.
+func enclosingStruct(file string, line int) string {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		return ""
+	}
+	name := ""
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return name == ""
+		}
+		if _, isStruct := spec.Type.(*ast.StructType); isStruct &&
+			fset.Position(spec.Pos()).Line <= line && line <= fset.Position(spec.End()).Line {
+			name = spec.Name.Name
+		}
+		return name == ""
+	})
+	return name
+}
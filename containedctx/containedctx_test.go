@@ -0,0 +1,93 @@
+package containedctx_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/surullabs/lint/containedctx"
+	"github.com/surullabs/lint/testutil"
+)
+
+const contained = `package containedctxtest
+
+import "context"
+
+// Server is a test struct
+type Server struct {
+	ctx  context.Context
+	name string
+}
+
+// Name is a test method
+func (s Server) Name() string {
+	return s.name
+}
+`
+
+func TestContainedctx(t *testing.T) {
+	testutil.Test(t, "containedctxtest", []testutil.StaticCheckTest{
+		{
+			Checker: containedctx.Check{},
+			Content: []byte(`package containedctxtest
+
+import "context"
+
+// Server is a test struct
+type Server struct {
+	name string
+}
+
+// Run is a test method
+func (s Server) Run(ctx context.Context) error {
+	return ctx.Err()
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  containedctx.Check{},
+			Content:  []byte(contained),
+			Validate: testutil.Contains("found a struct that contains a context.Context field"),
+		},
+		{
+			Checker:  containedctx.Check{},
+			Content:  []byte(contained),
+			Validate: testutil.Skip(containedctx.Skip("Server"), testutil.NoError),
+		},
+		{
+			Checker:  containedctx.Check{},
+			Content:  []byte(contained),
+			Validate: testutil.Skip(containedctx.Skip("Client"), testutil.Contains("context.Context field")),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: containedctx.Check{}, Expected: nil},
+	})
+}
+
+func TestSkip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containedctx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "a.go")
+	src := "package a\n\nimport \"context\"\n\ntype Server struct {\n\tctx context.Context\n}\n"
+	if err = ioutil.WriteFile(file, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	const msg = ":6:2: found a struct that contains a context.Context field"
+	testutil.TestSkips(t, []testutil.SkipTest{
+		{S: containedctx.Skip("Server"), Line: "no position", Skip: false},
+		{S: containedctx.Skip("Server"), Line: "missing.go:6:6: found a struct that contains a context.Context field", Skip: false},
+		{S: containedctx.Skip("Server"), Line: file + msg, Skip: true},
+		{S: containedctx.Skip("Server"), Line: "containedctx.Check: " + file + msg, Skip: true},
+		{S: containedctx.Skip("Client"), Line: file + msg, Skip: false},
+	})
+}
@@ -0,0 +1,39 @@
+// Package cyclop provides lint integration for the cyclop linter
+package cyclop
+
+import (
+	"strconv"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the cyclop linter (https://github.com/bkielbasa/cyclop), which reports
+// functions with a high cyclomatic complexity and, optionally, packages with a
+// high average complexity.
+type Check struct {
+	// Command sets the environment and working directory used to run cyclop
+	checkers.Command
+	// MaxComplexity is the maximum complexity of a function. If zero, the cyclop
+	// default of 10 is used.
+	MaxComplexity int
+	// PackageAverage is the maximum average complexity of the functions in a
+	// package. If zero, the average is not checked.
+	PackageAverage float64
+}
+
+// Check runs cyclop and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "cyclop", "", "github.com/bkielbasa/cyclop", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for cyclop
+func (c Check) Args() []string {
+	var args []string
+	if c.MaxComplexity > 0 {
+		args = append(args, "-maxComplexity", strconv.Itoa(c.MaxComplexity))
+	}
+	if c.PackageAverage > 0 {
+		args = append(args, "-packageAverage", strconv.FormatFloat(c.PackageAverage, 'g', -1, 64))
+	}
+	return args
+}
@@ -0,0 +1,63 @@
+package cyclop_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/cyclop"
+	"github.com/surullabs/lint/testutil"
+)
+
+const branchy = `package cycloptest
+
+// Classify is a test function
+func Classify(x int) string {
+	if x < 0 {
+		return "negative"
+	}
+	if x == 0 {
+		return "zero"
+	}
+	if x < 10 {
+		return "small"
+	}
+	return "large"
+}
+
+// Identity is a test function
+func Identity(x int) int {
+	return x
+}
+`
+
+func TestCyclop(t *testing.T) {
+	testutil.Test(t, "cycloptest", []testutil.StaticCheckTest{
+		{
+			Checker:  cyclop.Check{PackageAverage: 3},
+			Content:  []byte(branchy),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  cyclop.Check{PackageAverage: 2},
+			Content:  []byte(branchy),
+			Validate: testutil.Contains("the average complexity for the package cycloptest is 2.500000, max is 2.000000"),
+		},
+		{
+			Checker:  cyclop.Check{MaxComplexity: 3},
+			Content:  []byte(branchy),
+			Validate: testutil.Contains("calculated cyclomatic complexity for function Classify is 4, max is 3"),
+		},
+		{
+			Checker:  cyclop.Check{MaxComplexity: 3},
+			Content:  []byte(branchy),
+			Validate: testutil.SkippedErrors(`calculated cyclomatic complexity`),
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: cyclop.Check{}, Expected: nil},
+		{A: cyclop.Check{MaxComplexity: 15}, Expected: []string{"-maxComplexity", "15"}},
+		{A: cyclop.Check{PackageAverage: 5.5}, Expected: []string{"-packageAverage", "5.5"}},
+	})
+}
@@ -0,0 +1,55 @@
+package lint
+
+import (
+	"time"
+)
+
+// DeadlineExceeded is the error added by a Checker returned by Deadline when it
+// does not complete in time.
+const DeadlineExceeded = "lint.Deadline: deadline exceeded"
+
+type deadline struct {
+	d time.Duration
+	g Group
+}
+
+// Deadline returns a Checker that applies the checkers in g, as done by Group.Check,
+// but gives up once d has elapsed. Errors from checkers that completed are returned
+// along with DeadlineExceeded, which is reported as an operational error by Split.
+//
+// Checkers cannot be interrupted, so a checker that is running when the deadline
+// expires continues to run in the background, but its result is discarded. No
+// further checkers are started.
+func Deadline(d time.Duration, g Group) Checker {
+	return deadline{d: d, g: g}
+}
+
+func (dl deadline) Check(pkgs ...string) error {
+	results := make(chan error, len(dl.g))
+	expired := make(chan struct{})
+	go func() {
+		for _, checker := range dl.g {
+			select {
+			case <-expired:
+				return
+			default:
+			}
+			results <- Group{checker}.Check(pkgs...)
+		}
+	}()
+
+	timer := time.NewTimer(dl.d)
+	defer timer.Stop()
+	var res groupErrors
+	for range dl.g {
+		select {
+		case err := <-results:
+			res.add("", err)
+		case <-timer.C:
+			close(expired)
+			res.addOps(DeadlineExceeded)
+			return res.err()
+		}
+	}
+	return res.err()
+}
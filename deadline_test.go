@@ -0,0 +1,43 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/surullabs/lint"
+)
+
+func slow(d time.Duration, c lint.Checker) lint.Checker {
+	return checkFn(func(pkgs ...string) error {
+		time.Sleep(d)
+		return c.Check(pkgs...)
+	})
+}
+
+func TestDeadline(t *testing.T) {
+	var thirdRan bool
+	third := checkFn(func(...string) error {
+		thirdRan = true
+		return nil
+	})
+	err := lint.Deadline(50*time.Millisecond, lint.Group{
+		twoErrors,
+		slow(time.Second, ungroupedError),
+		third,
+	}).Check("./...")
+	expected := []string{"lint_test.checkFn: err1", "lint_test.checkFn: err2", lint.DeadlineExceeded}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%v", err))
+	assert(t, !thirdRan, "expected checker after the deadline to not run")
+
+	findings, ops := lint.Split(err)
+	assert(t, reflect.DeepEqual(errorList(findings), expected[:2]), fmt.Sprintf("%v", findings))
+	assert(t, reflect.DeepEqual(errorList(ops), []string{lint.DeadlineExceeded}), fmt.Sprintf("%v", ops))
+
+	// All checkers complete in time
+	err = lint.Deadline(time.Second, lint.Group{twoErrors, slow(time.Millisecond, ungroupedError)}).Check("./...")
+	assert(t, err != nil && err.Error() == "lint_test.checkFn: err1\nlint_test.checkFn: err2\nlint_test.checkFn: ungrouped: 1",
+		fmt.Sprintf("%v", err))
+	assert(t, lint.Deadline(time.Second, lint.Group{expectRecursive}).Check("./...") == nil, "expected no error")
+}
@@ -0,0 +1,36 @@
+// Package decorder provides lint integration for the decorder linter
+package decorder
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the decorder linter (https://gitlab.com/bosi/decorder)
+type Check struct {
+	// Command sets the environment and working directory used to run decorder
+	checkers.Command
+	// Order is the required order of declarations, such as type, const, var and func.
+	// decorder uses type, const, var, func if it is empty.
+	Order []string
+	// DisableInitFuncFirst allows init functions after other functions
+	DisableInitFuncFirst bool
+}
+
+// Check runs decorder and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "decorder", "", "gitlab.com/bosi/decorder/cmd/decorder", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for decorder
+func (c Check) Args() []string {
+	var args []string
+	if len(c.Order) > 0 {
+		args = append(args, "-dec-order", strings.Join(c.Order, ","))
+	}
+	if c.DisableInitFuncFirst {
+		args = append(args, "-disable-init-func-first-check")
+	}
+	return args
+}
@@ -0,0 +1,70 @@
+package decorder_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/decorder"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestDecorder(t *testing.T) {
+	order := []string{"type", "const", "var", "func"}
+	testutil.Test(t, "decordertest", []testutil.StaticCheckTest{
+		{
+			Checker: decorder.Check{Order: order},
+			Content: []byte(`package decordertest
+
+// Size is a test type
+type Size int
+
+// Max is a test constant
+const Max Size = 10
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: decorder.Check{Order: order},
+			Content: []byte(`package decordertest
+
+// Max is a test constant
+const Max = 10
+
+// Size is a test type
+type Size int
+`),
+			Validate: testutil.Contains("type must not be placed after const"),
+		},
+		{
+			Checker: decorder.Check{Order: order},
+			Content: []byte(`package decordertest
+
+// Max is a test constant
+const Max = 10
+
+// Size is a test type
+type Size int
+`),
+			Validate: testutil.SkippedErrors(`must not be placed after`),
+		},
+		{
+			Checker: decorder.Check{DisableInitFuncFirst: true},
+			Content: []byte(`package decordertest
+
+// Setup is a test function
+func Setup() {}
+
+func init() { Setup() }
+`),
+			Validate: testutil.NoError,
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: decorder.Check{}, Expected: nil},
+		{A: decorder.Check{Order: []string{"const", "type"}}, Expected: []string{"-dec-order", "const,type"}},
+		{A: decorder.Check{DisableInitFuncFirst: true}, Expected: []string{"-disable-init-func-first-check"}},
+	})
+}
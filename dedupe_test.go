@@ -0,0 +1,63 @@
+package lint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestDedupeAcrossPackages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dedupe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	real := filepath.Join(dir, "src", "example.com", "lib")
+	vendored := filepath.Join(dir, "src", "example.com", "app", "vendor", "example.com", "lib")
+	if err = os.MkdirAll(real, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(vendored), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(real, "lib.go"), []byte("package lib\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(real, vendored); err != nil {
+		t.Fatal(err)
+	}
+	a, b := filepath.Join(real, "lib.go"), filepath.Join(vendored, "lib.go")
+
+	report := &lint.Report{
+		Files: []string{a, b},
+		Results: []lint.Result{
+			{
+				Checker:  "gosec.Check",
+				Category: lint.CategorySecurity,
+				Findings: []string{
+					a + ":3:2: [G104] Errors unhandled.",
+					b + ":3:2: [G104] Errors unhandled.",
+					b + ":5:1: [G104] Errors unhandled.",
+					"no position",
+				},
+				Severities: []lint.Severity{lint.SeverityLow, lint.SeverityLow, lint.SeverityHigh, lint.SeverityUnknown},
+			},
+			{Checker: "errcheck.Check", Category: lint.CategoryCorrectness, Findings: []string{b + ":3:2: unchecked"}},
+		},
+	}
+	deduped := lint.DedupeAcrossPackages(report)
+	assert(t, reflect.DeepEqual(deduped.Files, []string{a}), fmt.Sprintf("%v", deduped.Files))
+	expected := []string{a + ":3:2: [G104] Errors unhandled.", b + ":5:1: [G104] Errors unhandled.", "no position"}
+	assert(t, reflect.DeepEqual(deduped.Results[0].Findings, expected), fmt.Sprintf("%q", deduped.Results[0].Findings))
+	assert(t, reflect.DeepEqual(deduped.Results[1].Findings, []string{b + ":3:2: unchecked"}), fmt.Sprintf("%q", deduped.Results[1].Findings))
+	assert(t, reflect.DeepEqual(deduped.ByCategory, map[string]int{lint.CategorySecurity: 3, lint.CategoryCorrectness: 1}),
+		fmt.Sprintf("%v", deduped.ByCategory))
+	assert(t, reflect.DeepEqual(deduped.BySeverity, map[lint.Severity]int{lint.SeverityLow: 1, lint.SeverityHigh: 1}),
+		fmt.Sprintf("%v", deduped.BySeverity))
+	assert(t, len(report.Results[0].Findings) == 4, "expected report to be unchanged")
+}
@@ -0,0 +1,37 @@
+package lint
+
+import "regexp"
+
+// ruleIDRE matches rule identifiers such as (SA1000) or [G104] in findings.
+var ruleIDRE = regexp.MustCompile(`[(\[]([A-Z]+[0-9]+)[)\]]`)
+
+type docsURL struct {
+	wrapped
+	fn func(ruleID string) string
+}
+
+// WithDocsURL returns a Checker that runs c and appends a link to the documentation
+// of the rule reported by each finding. The rule is identified by an ID of the form
+// (SA1000) or [G104] in the finding and fn returns the URL for it, or an empty
+// string if there is none. Findings without a rule ID are returned unchanged.
+//
+//    lint.WithDocsURL(gostaticcheck.DocsURL, gostaticcheck.Check{})
+func WithDocsURL(fn func(ruleID string) string, c Checker) Checker {
+	return docsURL{fn: fn, wrapped: wrapped{c}}
+}
+
+func (d docsURL) Check(pkgs ...string) error {
+	return mapFindings(d.checker.Check(pkgs...), d.link)
+}
+
+func (d docsURL) link(finding string) string {
+	m := ruleIDRE.FindAllStringSubmatch(finding, -1)
+	if m == nil {
+		return finding
+	}
+	// Use the last ID, since rule IDs follow the message in most linters.
+	if url := d.fn(m[len(m)-1][1]); url != "" {
+		return finding + " (" + url + ")"
+	}
+	return finding
+}
@@ -0,0 +1,42 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+	"github.com/surullabs/lint/gostaticcheck"
+)
+
+func TestWithDocsURL(t *testing.T) {
+	c := lint.WithDocsURL(gostaticcheck.DocsURL, lint.Stub(
+		"a.go:3:2: invalid regular expression: missing closing ) (SA1000)",
+		"a.go:7:1: unused variable x",
+	).WithName("gostaticcheck.Check"))
+	err := c.Check("./...")
+	expected := []string{
+		"a.go:3:2: invalid regular expression: missing closing ) (SA1000) (https://staticcheck.io/docs/checks#SA1000)",
+		"a.go:7:1: unused variable x",
+	}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	err = lint.Group{c}.Check("./...")
+	assert(t, errorList(err)[1] == "gostaticcheck.Check: a.go:7:1: unused variable x", fmt.Sprintf("%v", err))
+	assert(t, lint.CategoryOf(c) == lint.CategoryCorrectness, lint.CategoryOf(c))
+
+	gosec := lint.WithDocsURL(func(id string) string {
+		if id == "G104" {
+			return "https://securego.io/docs/rules/g104"
+		}
+		return ""
+	}, lint.Stub("a.go:1: [G104] Errors unhandled.", "a.go:2: [G999] Unknown."))
+	expected = []string{"a.go:1: [G104] Errors unhandled. (https://securego.io/docs/rules/g104)", "a.go:2: [G999] Unknown."}
+	err = gosec.Check()
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	err = lint.WithDocsURL(gostaticcheck.DocsURL, lint.StubError(fmt.Errorf("missing (SA1000)"))).Check()
+	_, ok := err.(checkers.OperationalError)
+	assert(t, ok && err.Error() == "missing (SA1000)", fmt.Sprintf("%v", err))
+}
@@ -3,7 +3,6 @@ package dupl
 import (
 	"bytes"
 	"fmt"
-	"os/exec"
 
 	"strconv"
 
@@ -16,6 +15,8 @@ import (
 
 // Check is implements lint.Checker for gofmt.
 type Check struct {
+	// Command sets the environment and working directory used to run dupl
+	checkers.Command
 	Threshold int
 }
 
@@ -65,7 +66,7 @@ func (c Check) Check(pkgs ...string) error {
 		t = 15
 	}
 	args := append([]string{"-t", strconv.Itoa(t)}, files...)
-	data, err := exec.Command(bin, args...).CombinedOutput()
+	data, err := checkers.Cmd(c.Command, bin, args...).CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("dupl failed: %v: %s", err, string(data))
 	}
@@ -0,0 +1,43 @@
+package lint
+
+import (
+	"os"
+	"strings"
+)
+
+// FromEnv returns a copy of g holding only the checkers selected using the
+// comma separated lists of checker names in the environment variables
+//
+//     LINT_ENABLE   keep only these checkers, if set
+//     LINT_DISABLE  drop these checkers
+//
+// This allows checkers to be toggled without changing code, as in
+//
+//     LINT_DISABLE=golint,dupl go test ./...
+//
+// Names are matched against the name used by Group, such as golint.Check, or its
+// package, such as golint. Checkers added to the returned Group, such as using
+// With, are not affected, so that checkers listed explicitly take precedence.
+func (g Group) FromEnv() Group {
+	enable := envNames("LINT_ENABLE")
+	disable := envNames("LINT_DISABLE")
+	var selected Group
+	for _, c := range g {
+		if (len(enable) > 0 && !hasName(enable, c)) || hasName(disable, c) {
+			continue
+		}
+		selected = append(selected, c)
+	}
+	return selected
+}
+
+// envNames returns the comma separated names held in the environment variable key.
+func envNames(key string) []string {
+	var names []string
+	for _, n := range strings.Split(os.Getenv(key), ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			names = append(names, n)
+		}
+	}
+	return names
+}
@@ -0,0 +1,53 @@
+package lint_test
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+// namedCheck is a Checker named by its value.
+type namedCheck string
+
+func (n namedCheck) Name() string          { return string(n) }
+func (n namedCheck) Check(...string) error { return nil }
+
+func names(g lint.Group) []string {
+	var all []string
+	for _, c := range g {
+		all = append(all, c.(lint.Named).Name())
+	}
+	return all
+}
+
+func TestFromEnv(t *testing.T) {
+	defer os.Unsetenv("LINT_ENABLE")
+	defer os.Unsetenv("LINT_DISABLE")
+	g := lint.Group{namedCheck("golint.Check"), namedCheck("dupl.Check"), namedCheck("errcheck.Check"), namedCheck("custom")}
+
+	for _, test := range []struct {
+		enable, disable string
+		expected        []string
+	}{
+		{expected: []string{"golint.Check", "dupl.Check", "errcheck.Check", "custom"}},
+		{disable: "golint,dupl", expected: []string{"errcheck.Check", "custom"}},
+		{disable: " errcheck.Check , ", expected: []string{"golint.Check", "dupl.Check", "custom"}},
+		{enable: "golint,custom", expected: []string{"golint.Check", "custom"}},
+		{enable: "golint,custom", disable: "custom", expected: []string{"golint.Check"}},
+		{enable: "missing", expected: nil},
+	} {
+		os.Setenv("LINT_ENABLE", test.enable)
+		os.Setenv("LINT_DISABLE", test.disable)
+		got := names(g.FromEnv())
+		assert(t, reflect.DeepEqual(got, test.expected), fmt.Sprintf("enable=%q disable=%q: %q", test.enable, test.disable, got))
+	}
+
+	// Checkers added explicitly are kept.
+	os.Setenv("LINT_ENABLE", "")
+	os.Setenv("LINT_DISABLE", "dupl")
+	got := names(g.FromEnv().With(namedCheck("dupl.Check")))
+	assert(t, reflect.DeepEqual(got, []string{"golint.Check", "errcheck.Check", "custom", "dupl.Check"}), fmt.Sprintf("%q", got))
+}
@@ -1,23 +1,37 @@
 // Package errcheck provides lint integration for the errcheck linter
 package errcheck
 
-import "github.com/surullabs/lint/checkers"
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
 
 // Check runs the errcheck linter (https://github.com/kisielk/errcheck)
 type Check struct {
+	// Command sets the environment and working directory used to run errcheck
+	checkers.Command
 	// Blank enables checking for assignments to the blank identifier
 	Blank bool
 	// Asserts enables checking for ignored type assertion results
 	Assert bool
 	// Tags is a list of space separated build tags
 	Tags string
+	// Ignore maps package paths to regular expressions matching functions in
+	// the package whose errors are not checked
+	Ignore map[string]string
+	// IgnorePkg is a list of package paths whose errors are not checked
+	IgnorePkg []string
 }
 
 // Check runs errcheck and returns any errors found.
 func (c Check) Check(pkgs ...string) error {
-	return checkers.Lint("errcheck", "", "github.com/kisielk/errcheck", pkgs, c.Args()...)
+	return checkers.LintCommand(c.Command, "errcheck", "", "github.com/kisielk/errcheck", pkgs, c.Args()...)
 }
 
+// Name returns errcheck.Check, the name used to identify c in a Group.
+func (c Check) Name() string { return "errcheck.Check" }
+
 // Args returns command line arguments used for errcheck
 func (c Check) Args() []string {
 	var args []string
@@ -30,5 +44,15 @@ func (c Check) Args() []string {
 	if c.Tags != "" {
 		args = append(args, "-tags", c.Tags)
 	}
+	if len(c.Ignore) > 0 {
+		var ignore []string
+		for _, pkg := range checkers.SortedKeys(c.Ignore) {
+			ignore = append(ignore, pkg+":"+c.Ignore[pkg])
+		}
+		args = append(args, "-ignore", strings.Join(ignore, ","))
+	}
+	if len(c.IgnorePkg) > 0 {
+		args = append(args, "-ignorepkg", strings.Join(c.IgnorePkg, ","))
+	}
 	return args
 }
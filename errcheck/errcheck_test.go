@@ -67,16 +67,74 @@ func TestFunc() {
 `),
 			Validate: testutil.Contains("_ = i.(int)"),
 		},
+		{
+			Checker: errcheck.Check{Ignore: map[string]string{"fmt": "^Println$", "os": "^Remove$"}},
+			Content: []byte(`package errchecktest
+import (
+	"fmt"
+	"os"
+)
+
+func TestFunc() {
+	fmt.Println("removing")
+	os.Remove("somefile")
+	os.Open("somefile")
+}
+`),
+			Validate: testutil.HasSuffix(`os.Open("somefile")`),
+		},
+		{
+			Checker: errcheck.Check{Ignore: map[string]string{"fmt": "^Println$", "os": "^Remove$"}},
+			Content: []byte(`package errchecktest
+import (
+	"fmt"
+	"os"
+)
+
+func TestFunc() {
+	fmt.Println("removing")
+	os.Remove("somefile")
+}
+`),
+			Validate: testutil.NoError,
+		},
 	},
 	)
 }
 
+func BenchmarkErrcheck(b *testing.B) {
+	testutil.Benchmark(b, errcheck.Check{}, []byte(`package lintbenchmark
+
+import "os"
+
+// Remove is a benchmark function
+func Remove() {
+	os.Remove("somefile")
+}
+`))
+}
+
+var ignoreMany = errcheck.Check{Ignore: map[string]string{
+	"os":        "^Remove$",
+	"io/ioutil": ".*",
+	"net/http":  "Close",
+	"io":        "Copy",
+}}
+
 func TestArgs(t *testing.T) {
+	// Map iteration order varies between runs, so check the order is stable.
+	for i := 0; i < 10; i++ {
+		testutil.TestArgs(t, []testutil.ArgTest{
+			{A: ignoreMany, Expected: []string{"-ignore", "io:Copy,io/ioutil:.*,net/http:Close,os:^Remove$"}},
+		})
+	}
 	testutil.TestArgs(t, []testutil.ArgTest{
 		{A: errcheck.Check{}, Expected: nil},
 		{A: errcheck.Check{Blank: true}, Expected: []string{"-blank"}},
 		{A: errcheck.Check{Assert: true}, Expected: []string{"-asserts"}},
 		{A: errcheck.Check{Tags: "test"}, Expected: []string{"-tags", "test"}},
 		{A: errcheck.Check{Blank: true, Assert: true}, Expected: []string{"-blank", "-asserts"}},
+		{A: errcheck.Check{Ignore: map[string]string{"os": "^Remove$", "fmt": ".*"}}, Expected: []string{"-ignore", "fmt:.*,os:^Remove$"}},
+		{A: errcheck.Check{IgnorePkg: []string{"io", "bytes"}}, Expected: []string{"-ignorepkg", "io,bytes"}},
 	})
 }
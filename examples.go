@@ -0,0 +1,86 @@
+package lint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type examplesOnly struct {
+	wrapped
+}
+
+// ExamplesOnly returns a Checker that runs c and reports only those findings which
+// are in Example functions in _test.go files. The function containing a finding is
+// found by parsing the file it refers to. Findings without a position are dropped,
+// while operational errors are retained. This allows stricter rules to be applied
+// to examples than to the rest of the code.
+//
+//    lint.ExamplesOnly(golint.Check{})
+func ExamplesOnly(c Checker) Checker {
+	return examplesOnly{wrapped: wrapped{c}}
+}
+
+func (e examplesOnly) Check(pkgs ...string) error {
+	err := e.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	funcs := map[string][]*funcLines{}
+	var errs []string
+	for _, f := range findings(found) {
+		_, file, line, _, _, ok := parseLabeled(f)
+		if !ok || !strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		if _, parsed := funcs[file]; !parsed {
+			funcs[file] = fileFuncs(file)
+		}
+		if name := enclosingFunc(funcs[file], line); strings.HasPrefix(name, "Example") {
+			errs = append(errs, f)
+		}
+	}
+	return withOps(errs, ops)
+}
+
+// funcLines holds the name and line range of a function declaration.
+type funcLines struct {
+	name       string
+	start, end int
+}
+
+// fileFuncs returns the top level functions declared in file, or nil if it cannot
+// be parsed.
+func fileFuncs(file string) []*funcLines {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		return nil
+	}
+	var funcs []*funcLines
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			funcs = append(funcs, &funcLines{
+				name:  fn.Name.Name,
+				start: fset.Position(fn.Pos()).Line,
+				end:   fset.Position(fn.End()).Line,
+			})
+		}
+	}
+	return funcs
+}
+
+// enclosingFunc returns the name of the function in funcs containing line, or an
+// empty string if there is none.
+func enclosingFunc(funcs []*funcLines, line int) string {
+	for _, fn := range funcs {
+		if fn.start <= line && line <= fn.end {
+			return fn.name
+		}
+	}
+	return ""
+}
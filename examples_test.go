@@ -0,0 +1,59 @@
+package lint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+const exampleFile = `package p_test
+
+import "fmt"
+
+func ExampleHello() {
+	fmt.Println("hello")
+	// Output: hello
+}
+
+func TestHello(t *testing.T) {
+	fmt.Println("hello")
+}
+`
+
+func TestExamplesOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "examples")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "p_test.go")
+	if err = ioutil.WriteFile(file, []byte(exampleFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	other := filepath.Join(dir, "p.go")
+	if err = ioutil.WriteFile(other, []byte(exampleFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c := lint.ExamplesOnly(lint.Stub(
+		file+":6:2: in example",
+		file+":11:2: in test",
+		file+":3:1: in imports",
+		other+":6:2: not a test file",
+		"no position",
+	))
+	err = c.Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), []string{file + ":6:2: in example"}), fmt.Sprintf("%q", errorList(err)))
+
+	// Findings prefixed by a Group are also matched.
+	err = lint.ExamplesOnly(lint.Group{lint.Stub(file+":6:2: in example", file+":11:2: in test")}).Check()
+	assert(t, reflect.DeepEqual(errorList(err), []string{"lint.Stub: " + file + ":6:2: in example"}), fmt.Sprintf("%q", errorList(err)))
+
+	assert(t, lint.ExamplesOnly(lint.Stub(file+":11:2: in test")).Check() == nil, "expected no error")
+	err = lint.ExamplesOnly(lint.StubError(fmt.Errorf("not installed"))).Check()
+	assert(t, err != nil && err.Error() == "not installed", fmt.Sprintf("%v", err))
+}
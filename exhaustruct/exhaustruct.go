@@ -0,0 +1,37 @@
+// Package exhaustruct provides lint integration for the exhaustruct linter
+package exhaustruct
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the exhaustruct linter (https://github.com/GaijinEntertainment/go-exhaustruct)
+type Check struct {
+	// Command sets the environment and working directory used to run exhaustruct
+	checkers.Command
+	// Include holds regular expressions matching the full names of the struct types
+	// to check, such as `.*\.Config`. All types are checked if it is empty.
+	Include []string
+	// Exclude holds regular expressions matching the full names of struct types
+	// which are not checked
+	Exclude []string
+}
+
+// Check runs exhaustruct and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "exhaustruct", "", "github.com/GaijinEntertainment/go-exhaustruct/v3/cmd/exhaustruct", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for exhaustruct
+func (c Check) Args() []string {
+	var args []string
+	if len(c.Include) > 0 {
+		args = append(args, "-i", strings.Join(c.Include, ","))
+	}
+	if len(c.Exclude) > 0 {
+		args = append(args, "-e", strings.Join(c.Exclude, ","))
+	}
+	return args
+}
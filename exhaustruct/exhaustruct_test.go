@@ -0,0 +1,67 @@
+package exhaustruct_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/exhaustruct"
+	"github.com/surullabs/lint/testutil"
+)
+
+const incomplete = `package exhaustructtest
+
+// Config is a test type
+type Config struct {
+	Name    string
+	Retries int
+}
+
+// Default is a test function
+func Default() Config {
+	return Config{Name: "default"}
+}
+`
+
+func TestExhaustruct(t *testing.T) {
+	testutil.Test(t, "exhaustructtest", []testutil.StaticCheckTest{
+		{
+			Checker: exhaustruct.Check{Include: []string{`.*\.Config`}},
+			Content: []byte(`package exhaustructtest
+
+// Config is a test type
+type Config struct {
+	Name    string
+	Retries int
+}
+
+// Default is a test function
+func Default() Config {
+	return Config{Name: "default", Retries: 3}
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  exhaustruct.Check{Include: []string{`.*\.Config`}},
+			Content:  []byte(incomplete),
+			Validate: testutil.MatchesRegexp(`Config is missing field Retries`),
+		},
+		{
+			Checker:  exhaustruct.Check{Include: []string{`.*\.Config`}},
+			Content:  []byte(incomplete),
+			Validate: testutil.SkippedErrors(`is missing field`),
+		},
+		{
+			Checker:  exhaustruct.Check{Exclude: []string{`.*\.Config`}},
+			Content:  []byte(incomplete),
+			Validate: testutil.NoError,
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: exhaustruct.Check{}, Expected: nil},
+		{A: exhaustruct.Check{Include: []string{`.*\.Config`, `.*\.Options`}}, Expected: []string{"-i", `.*\.Config,.*\.Options`}},
+		{A: exhaustruct.Check{Exclude: []string{`.*\.Cache`}}, Expected: []string{"-e", `.*\.Cache`}},
+	})
+}
@@ -0,0 +1,98 @@
+package lint
+
+import (
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ExpandOptions controls which directories are included by ExpandPackages.
+type ExpandOptions struct {
+	// Vendor includes packages in vendor directories.
+	Vendor bool
+	// Testdata includes packages in testdata directories.
+	Testdata bool
+	// Hidden includes packages in directories with names starting with . or _
+	Hidden bool
+}
+
+// ExpandPackages returns all packages in the directory tree rooted at root. This
+// is similar to the ... wildcard, but gives consistent results for all checkers,
+// since linters differ in how they expand wildcards.
+//
+// A package is any directory containing .go files. Packages are returned as import
+// paths if they are in the GOPATH and as paths relative to the working directory,
+// such as ./pkg, if not. The returned packages are sorted.
+func ExpandPackages(root string, opts ExpandOptions) ([]string, error) {
+	var pkgs []string
+	err := filepath.Walk(root, func(path string, stat os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if !stat.IsDir() {
+			return nil
+		}
+		if path != root && !opts.include(stat.Name()) {
+			return filepath.SkipDir
+		}
+		hasGo, err := hasGoFiles(path)
+		if err != nil || !hasGo {
+			return err
+		}
+		pkg, err := importPath(path)
+		if err != nil {
+			return err
+		}
+		pkgs = append(pkgs, pkg)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand packages in %s: %v", root, err)
+	}
+	sort.Strings(pkgs)
+	return pkgs, nil
+}
+
+func (o ExpandOptions) include(dir string) bool {
+	switch {
+	case dir == "vendor":
+		return o.Vendor
+	case dir == "testdata":
+		return o.Testdata
+	case strings.HasPrefix(dir, ".") || strings.HasPrefix(dir, "_"):
+		return o.Hidden
+	}
+	return true
+}
+
+func hasGoFiles(dir string) (bool, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".go") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// importPath returns the import path for the package in dir or a relative path
+// if dir is not in the GOPATH.
+func importPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, src := range build.Default.SrcDirs() {
+		if rel, err := filepath.Rel(src, abs); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+	return relativePackage(abs)
+}
@@ -0,0 +1,63 @@
+package lint_test
+
+import (
+	"fmt"
+	"go/build"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint"
+)
+
+func TestExpandPackages(t *testing.T) {
+	src := []byte("package p\n")
+	var files []fakegopath.SourceFile
+	for _, f := range []string{
+		"a/a.go", "a/b/b.go", "vendor/v/v.go", "a/testdata/t.go",
+		".hidden/h.go", "_ignored/i.go", "docs/README.md", "root.go",
+	} {
+		files = append(files, fakegopath.SourceFile{Content: src, Dest: filepath.Join("expandtest", f)})
+	}
+	tmp, err := fakegopath.NewTemporaryWithFiles("expandtest", files)
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+	p, err := build.Import("expandtest", "", build.FindOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		opts     lint.ExpandOptions
+		expected []string
+	}{
+		{
+			opts:     lint.ExpandOptions{},
+			expected: []string{"expandtest", "expandtest/a", "expandtest/a/b"},
+		},
+		{
+			opts:     lint.ExpandOptions{Vendor: true},
+			expected: []string{"expandtest", "expandtest/a", "expandtest/a/b", "expandtest/vendor/v"},
+		},
+		{
+			opts:     lint.ExpandOptions{Testdata: true},
+			expected: []string{"expandtest", "expandtest/a", "expandtest/a/b", "expandtest/a/testdata"},
+		},
+		{
+			opts: lint.ExpandOptions{Hidden: true},
+			expected: []string{
+				"expandtest", "expandtest/.hidden", "expandtest/_ignored", "expandtest/a", "expandtest/a/b",
+			},
+		},
+	} {
+		pkgs, err := lint.ExpandPackages(p.Dir, test.opts)
+		assert(t, err == nil, fmt.Sprintf("%v", err))
+		assert(t, reflect.DeepEqual(pkgs, test.expected), fmt.Sprintf("%+v: %v", test.opts, pkgs))
+	}
+
+	_, err = lint.ExpandPackages(filepath.Join(p.Dir, "missing"), lint.ExpandOptions{})
+	assert(t, err != nil, "expected error for missing root")
+}
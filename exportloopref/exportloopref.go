@@ -0,0 +1,24 @@
+// Package exportloopref provides lint integration for the exportloopref linter
+package exportloopref
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the exportloopref linter (https://github.com/kyoh86/exportloopref)
+//
+// Since Go 1.22 each iteration of a loop has its own copy of the loop variables, so
+// pointers to them no longer alias. exportloopref is only useful for modules which
+// declare an earlier Go version.
+type Check struct {
+	// Command sets the environment and working directory used to run exportloopref
+	checkers.Command
+}
+
+// Check runs exportloopref and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "exportloopref", "", "github.com/kyoh86/exportloopref/cmd/exportloopref", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for exportloopref
+func (c Check) Args() []string {
+	return nil
+}
@@ -0,0 +1,66 @@
+package exportloopref_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/exportloopref"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestExportloopref(t *testing.T) {
+	testutil.Test(t, "exportloopreftest", []testutil.StaticCheckTest{
+		{
+			Checker: exportloopref.Check{},
+			Content: []byte(`package exportloopreftest
+
+// Pointers is a test function
+func Pointers() []*int {
+	var ptrs []*int
+	for i := 0; i < 3; i++ {
+		i := i
+		ptrs = append(ptrs, &i)
+	}
+	return ptrs
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: exportloopref.Check{},
+			Content: []byte(`package exportloopreftest
+
+// Pointers is a test function
+func Pointers() []*int {
+	var ptrs []*int
+	for i := 0; i < 3; i++ {
+		ptrs = append(ptrs, &i)
+	}
+	return ptrs
+}
+`),
+			Validate: testutil.Contains("exporting a pointer for the loop variable i"),
+		},
+		{
+			Checker: exportloopref.Check{},
+			Content: []byte(`package exportloopreftest
+
+// Pointers is a test function
+func Pointers() []*int {
+	var ptrs []*int
+	for i := 0; i < 3; i++ {
+		ptrs = append(ptrs, &i)
+	}
+	return ptrs
+}
+`),
+			Validate: testutil.SkippedErrors(`exporting a pointer for the loop variable`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: exportloopref.Check{}, Expected: nil},
+	})
+}
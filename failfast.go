@@ -0,0 +1,31 @@
+package lint
+
+type failFast Group
+
+// FailFast returns a Checker that applies each of the checkers in g in order, but
+// stops as soon as one returns an error. The error is returned as described in
+// Group.Check and the remaining checkers are not run.
+func FailFast(g Group) Checker {
+	return failFast(g)
+}
+
+// ConcurrentFailFast returns a Checker that applies the checkers in g concurrently,
+// as done by Concurrent, but returns as soon as one returns an error. No further
+// checkers are started and the errors of the checkers that completed are returned,
+// in the order of the checkers in g.
+//
+// Checkers cannot be interrupted, so checkers that are running when another fails
+// continue to run in the background, but their results are discarded, as done by
+// Deadline.
+func ConcurrentFailFast(n int, g Group) Checker {
+	return concurrent{n: n, g: g, failFast: true}
+}
+
+func (f failFast) Check(pkgs ...string) error {
+	for _, checker := range f {
+		if err := (Group{checker}).Check(pkgs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
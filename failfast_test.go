@@ -0,0 +1,76 @@
+package lint_test
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestFailFast(t *testing.T) {
+	var calls []string
+	record := func(name string, c lint.Checker) lint.Checker {
+		return checkFn(func(pkgs ...string) error {
+			calls = append(calls, name)
+			return c.Check(pkgs...)
+		})
+	}
+
+	err := lint.FailFast(lint.Group{
+		record("first", expectRecursive),
+		record("second", twoErrors),
+		record("third", ungroupedError),
+	}).Check("./...")
+	assert(t, err != nil && err.Error() == "lint_test.checkFn: err1\nlint_test.checkFn: err2",
+		fmt.Sprintf("%v", err))
+	assert(t, fmt.Sprint(calls) == "[first second]", fmt.Sprint(calls))
+
+	calls = nil
+	err = lint.FailFast(lint.Group{record("first", expectRecursive), record("second", expectRecursive)}).Check("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, fmt.Sprint(calls) == "[first second]", fmt.Sprint(calls))
+}
+
+func TestConcurrentFailFast(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	release := make(chan struct{})
+	defer close(release)
+	record := func(name string, c lint.Checker) lint.Checker {
+		return checkFn(func(pkgs ...string) error {
+			mu.Lock()
+			calls = append(calls, name)
+			mu.Unlock()
+			return c.Check(pkgs...)
+		})
+	}
+	started := make(chan struct{})
+	blocked := checkFn(func(...string) error {
+		close(started)
+		<-release
+		return nil
+	})
+	afterStart := checkFn(func(pkgs ...string) error {
+		<-started
+		return twoErrors.Check(pkgs...)
+	})
+
+	// The blocked checker is abandoned and the third is never started.
+	err := lint.ConcurrentFailFast(2, lint.Group{
+		record("first", blocked),
+		record("second", afterStart),
+		record("third", ungroupedError),
+	}).Check("./...")
+	assert(t, err != nil && err.Error() == "lint_test.checkFn: err1\nlint_test.checkFn: err2",
+		fmt.Sprintf("%v", err))
+	mu.Lock()
+	sort.Strings(calls)
+	assert(t, fmt.Sprint(calls) == "[first second]", fmt.Sprint(calls))
+	mu.Unlock()
+
+	err = lint.ConcurrentFailFast(2, lint.Group{expectRecursive, expectRecursive, ungroupedError}).Check("./...")
+	assert(t, err != nil && err.Error() == "lint_test.checkFn: ungrouped: 1", fmt.Sprintf("%v", err))
+	assert(t, lint.ConcurrentFailFast(0, lint.Group{expectRecursive}).Check("./...") == nil, "expected no error")
+}
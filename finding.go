@@ -0,0 +1,126 @@
+package lint
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// findingRE matches a finding of the form file:line[:col][: message]. The file may
+// contain colons, such as a Windows drive letter, but not a colon followed by a space,
+// which separates the message.
+var findingRE = regexp.MustCompile(`^((?:[^:]|:[^\s0-9])+?):([0-9]+)(?::([0-9]+))?(?::\s*(.*))?$`)
+
+// ParseFinding parses a finding of the form
+//
+//     file:line:col: message
+//     file:line: message
+//
+// as reported by most linters. col is 0 if the finding has no column. ok is false
+// if line does not start with a position.
+//
+// File paths may contain colons, including Windows drive letters such as C:\src\a.go.
+// ParseFinding does not handle findings prefixed with the name of a checker by Group.
+func ParseFinding(line string) (file string, lineNo, col int, msg string, ok bool) {
+	m := findingRE.FindStringSubmatch(line)
+	if m == nil {
+		return "", 0, 0, "", false
+	}
+	var err error
+	if lineNo, err = strconv.Atoi(m[2]); err != nil {
+		return "", 0, 0, "", false
+	}
+	if m[3] != "" {
+		if col, err = strconv.Atoi(m[3]); err != nil {
+			return "", 0, 0, "", false
+		}
+	}
+	return m[1], lineNo, col, strings.TrimSpace(m[4]), true
+}
+
+// parseLabeled is like ParseFinding, but additionally handles findings prefixed by the
+// name of a checker, as done by Group, returning the prefix as label.
+func parseLabeled(finding string) (label, file string, line, col int, msg string, ok bool) {
+	if file, line, col, msg, ok = ParseFinding(finding); ok {
+		return "", file, line, col, msg, true
+	}
+	i := strings.Index(finding, ": ")
+	if i < 0 || strings.ContainsAny(finding[:i], " \t") {
+		return "", "", 0, 0, "", false
+	}
+	if file, line, col, msg, ok = ParseFinding(finding[i+2:]); ok {
+		return finding[:i], file, line, col, msg, true
+	}
+	return "", "", 0, 0, "", false
+}
+
+// CheckerOf parses a finding prefixed with the name of the checker that reported
+// it, as done by Group, such as
+//
+//     errcheck.Check: a.go:10:5: x
+//
+// returning the checker name and the remaining finding, which can be parsed using
+// ParseFinding. The name may contain colons, but not whitespace. ok is false if line
+// is not labeled or if the remaining finding does not start with a position.
+func CheckerOf(line string) (name string, rest string, ok bool) {
+	label, _, _, _, _, ok := parseLabeled(line)
+	if !ok || label == "" {
+		return "", "", false
+	}
+	return label, line[len(label)+2:], true
+}
+
+// Lines returns the individual findings contained in err. If err implements
+//
+//     type errors interface {
+//     	Errors() []string
+//     }
+//
+// the strings returned by Errors are used, otherwise err.Error() is split into lines.
+// Blank findings are dropped. Lines returns nil if err is nil.
+func Lines(err error) []string {
+	var all []string
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case errors:
+		all = e.Errors()
+	default:
+		all = strings.Split(e.Error(), "\n")
+	}
+	var lines []string
+	for _, l := range all {
+		if strings.TrimSpace(l) != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// Join returns an error holding lines, in the same form as the errors returned by
+// checkers. It is the inverse of Lines and returns nil if lines is empty.
+func Join(lines []string) error {
+	return checkers.Error(lines...)
+}
+
+// findings returns the individual findings contained in err. Unlike Lines, an error
+// which does not implement Errors is a single finding, even if it spans several lines.
+func findings(err error) []string {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case errors:
+		return e.Errors()
+	default:
+		return []string{e.Error()}
+	}
+}
+
+// findingFile returns the file a finding refers to or an empty string
+// if it has no position information.
+func findingFile(finding string) string {
+	_, file, _, _, _, _ := parseLabeled(finding)
+	return file
+}
@@ -0,0 +1,77 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestParseFinding(t *testing.T) {
+	for _, test := range []struct {
+		line   string
+		file   string
+		lineNo int
+		col    int
+		msg    string
+		ok     bool
+	}{
+		{line: "a.go:10:5: x", file: "a.go", lineNo: 10, col: 5, msg: "x", ok: true},
+		{line: "a.go:10: x", file: "a.go", lineNo: 10, msg: "x", ok: true},
+		{line: `C:\a\b.go:3:2: x`, file: `C:\a\b.go`, lineNo: 3, col: 2, msg: "x", ok: true},
+		{line: `C:\a\b.go:3: x: y`, file: `C:\a\b.go`, lineNo: 3, msg: "x: y", ok: true},
+		{line: "/src/a:b/c.go:7:1: has: colons", file: "/src/a:b/c.go", lineNo: 7, col: 1, msg: "has: colons", ok: true},
+		{line: "/src/pkg/file.go:8:9:\tf.Close()", file: "/src/pkg/file.go", lineNo: 8, col: 9, msg: "f.Close()", ok: true},
+		{line: "go.mod:12: local replacement", file: "go.mod", lineNo: 12, msg: "local replacement", ok: true},
+		{line: "a.go:10", file: "a.go", lineNo: 10, ok: true},
+		{line: "a.go:10:4", file: "a.go", lineNo: 10, col: 4, ok: true},
+		{line: "no position here"},
+		{line: "ungrouped: 1"},
+		{line: "errcheck.Check: a.go:10: x"},
+		{line: ""},
+	} {
+		file, lineNo, col, msg, ok := lint.ParseFinding(test.line)
+		assert(t, file == test.file && lineNo == test.lineNo && col == test.col && msg == test.msg && ok == test.ok,
+			fmt.Sprintf("%q: got %q %d %d %q %v", test.line, file, lineNo, col, msg, ok))
+	}
+}
+
+func TestCheckerOf(t *testing.T) {
+	for _, test := range []struct {
+		line, name, rest string
+		ok               bool
+	}{
+		{line: "errcheck.Check: a.go:10:5: x", name: "errcheck.Check", rest: "a.go:10:5: x", ok: true},
+		{line: "lint_test.checkFn: a.go:10: x: y", name: "lint_test.checkFn", rest: "a.go:10: x: y", ok: true},
+		{line: `golint.Check: C:\a\b.go:3:2: x`, name: "golint.Check", rest: `C:\a\b.go:3:2: x`, ok: true},
+		{line: "vendor:errcheck.Check: a.go:1: x", name: "vendor:errcheck.Check", rest: "a.go:1: x", ok: true},
+		{line: "a.go:10:5: x"},
+		{line: `C:\a\b.go:3: x: y`},
+		{line: "ungrouped: 1"},
+		{line: "lint.Stub: no position"},
+		{line: "not a checker: a.go:1: x"},
+		{line: ""},
+	} {
+		name, rest, ok := lint.CheckerOf(test.line)
+		assert(t, name == test.name && rest == test.rest && ok == test.ok,
+			fmt.Sprintf("%q: got %q %q %v", test.line, name, rest, ok))
+	}
+}
+
+func TestLinesJoin(t *testing.T) {
+	assert(t, lint.Lines(nil) == nil, "expected no lines for nil")
+	assert(t, lint.Join(nil) == nil, "expected nil error for no lines")
+	assert(t, lint.Join([]string{}) == nil, "expected nil error for empty lines")
+
+	lines := []string{"a.go:1: first", "b.go:2:3: second"}
+	err := lint.Join(lines)
+	assert(t, err.Error() == "a.go:1: first\nb.go:2:3: second", err.Error())
+	assert(t, reflect.DeepEqual(lint.Lines(err), lines), fmt.Sprintf("%q", lint.Lines(err)))
+	assert(t, reflect.DeepEqual(lint.Lines(fmt.Errorf("%v", err)), lines), "plain errors must be split into lines")
+
+	blanks := fmt.Errorf("\na.go:1: first\n\n  \nb.go:2:3: second\n\n")
+	assert(t, reflect.DeepEqual(lint.Lines(blanks), lines), fmt.Sprintf("%q", lint.Lines(blanks)))
+	withBlanks := lint.Join([]string{"", "a.go:1: first", " ", "b.go:2:3: second", ""})
+	assert(t, reflect.DeepEqual(lint.Lines(withBlanks), lines), fmt.Sprintf("%q", lint.Lines(withBlanks)))
+}
@@ -0,0 +1,69 @@
+// Package gci provides lint integration for the gci linter
+package gci
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the gci linter (https://github.com/daixiang0/gci) to verify that imports
+// are grouped into sections in the configured order.
+type Check struct {
+	// Command sets the environment and working directory used to run gci
+	checkers.Command
+	// Sections lists import sections in the required order, such as standard,
+	// default and prefix(github.com/org). gci uses standard and default if it is empty.
+	Sections []string
+}
+
+// Check runs
+//   gci diff <args> <files>
+//
+// for all files in pkgs and reports a finding for each file whose imports need
+// reordering.
+func (c Check) Check(pkgs ...string) error {
+	files, err := checkers.GoFiles(pkgs...)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	bin, err := checkers.InstallMissing("gci", "github.com/daixiang0/gci", "github.com/daixiang0/gci")
+	if err != nil {
+		return err
+	}
+	args := append(append([]string{"diff"}, c.Args()...), files...)
+	res, err := checkers.Exec(checkers.Cmd(c.Command, bin, args...))
+	if err != nil && strings.TrimSpace(res.Stdout) == "" {
+		return checkers.Operational(fmt.Errorf("gci failed: %v: %s", err, strings.TrimSpace(res.Stderr)))
+	}
+	return checkers.Error(splitDiff(res.Stdout)...)
+}
+
+// splitDiff splits a unified diff covering several files into one diff per file.
+func splitDiff(diff string) []string {
+	diff = strings.TrimSpace(diff)
+	if diff == "" {
+		return nil
+	}
+	var files []string
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "--- ") || len(files) == 0 {
+			files = append(files, "imports not in section order:")
+		}
+		files[len(files)-1] += "\n" + line
+	}
+	return files
+}
+
+// Args returns command line arguments used for gci
+func (c Check) Args() []string {
+	var args []string
+	for _, s := range c.Sections {
+		args = append(args, "-s", s)
+	}
+	return args
+}
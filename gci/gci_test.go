@@ -0,0 +1,69 @@
+package gci_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/gci"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestGci(t *testing.T) {
+	sections := []string{"standard", "default", "prefix(github.com/surullabs)"}
+	testutil.Test(t, "gcitest", []testutil.StaticCheckTest{
+		{
+			Checker: gci.Check{Sections: sections},
+			Content: []byte(`package gcitest
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/surullabs/lint"
+)
+
+var _ = fmt.Sprint(errors.New(""), lint.Group{})
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gci.Check{Sections: sections},
+			Content: []byte(`package gcitest
+
+import (
+	"github.com/surullabs/lint"
+
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+var _ = fmt.Sprint(errors.New(""), lint.Group{})
+`),
+			Validate: testutil.Contains("imports not in section order"),
+		},
+		{
+			Checker: gci.Check{Sections: sections},
+			Content: []byte(`package gcitest
+
+import (
+	"github.com/surullabs/lint"
+
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+var _ = fmt.Sprint(errors.New(""), lint.Group{})
+`),
+			Validate: testutil.SkippedErrors(`imports not in section order`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gci.Check{}, Expected: nil},
+		{A: gci.Check{Sections: []string{"standard", "default"}}, Expected: []string{"-s", "standard", "-s", "default"}},
+		{A: gci.Check{Sections: []string{"standard", "prefix(github.com/org)"}}, Expected: []string{"-s", "standard", "-s", "prefix(github.com/org)"}},
+	})
+}
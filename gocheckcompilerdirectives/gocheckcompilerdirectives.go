@@ -0,0 +1,24 @@
+// Package gocheckcompilerdirectives provides lint integration for the
+// gocheckcompilerdirectives linter
+package gocheckcompilerdirectives
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the gocheckcompilerdirectives linter
+// (https://github.com/leighmcculloch/gocheckcompilerdirectives) which verifies that
+// //go: directives are well formed.
+type Check struct {
+	// Command sets the environment and working directory used to run gocheckcompilerdirectives
+	checkers.Command
+}
+
+// Check runs gocheckcompilerdirectives and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "gocheckcompilerdirectives", "",
+		"github.com/leighmcculloch/gocheckcompilerdirectives", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for gocheckcompilerdirectives
+func (c Check) Args() []string {
+	return nil
+}
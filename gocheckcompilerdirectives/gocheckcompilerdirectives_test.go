@@ -0,0 +1,56 @@
+package gocheckcompilerdirectives_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/gocheckcompilerdirectives"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestGocheckcompilerdirectives(t *testing.T) {
+	testutil.Test(t, "directivestest", []testutil.StaticCheckTest{
+		{
+			Checker: gocheckcompilerdirectives.Check{},
+			Content: []byte(`package directivestest
+
+//go:generate echo generated
+
+// TestFunc is a test function
+func TestFunc() {
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gocheckcompilerdirectives.Check{},
+			Content: []byte(`package directivestest
+
+// go:generate echo generated
+
+// TestFunc is a test function
+func TestFunc() {
+}
+`),
+			Validate: testutil.Contains("compiler directive comment has a space"),
+		},
+		{
+			Checker: gocheckcompilerdirectives.Check{},
+			Content: []byte(`package directivestest
+
+// go:generate echo generated
+
+// TestFunc is a test function
+func TestFunc() {
+}
+`),
+			Validate: testutil.SkippedErrors(`file\.go:3:1: compiler directive`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gocheckcompilerdirectives.Check{}, Expected: nil},
+	})
+}
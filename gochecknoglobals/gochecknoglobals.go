@@ -0,0 +1,130 @@
+// Package gochecknoglobals provides a lint check which reports global variables,
+// similar to gochecknoglobals (https://github.com/leighmcculloch/gochecknoglobals).
+package gochecknoglobals
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check reports package level variables declared in the non test files of the
+// checked packages. The blank identifier is never reported.
+type Check struct {
+	// AllowErrVars allows variables with names starting with Err or err which are
+	// initialized using errors.New or fmt.Errorf.
+	AllowErrVars bool
+	// AllowEmbeds allows variables initialized using a //go:embed directive.
+	AllowEmbeds bool
+	// AllowNames holds regular expressions matching the names of allowed variables.
+	AllowNames []string
+}
+
+// Args returns the settings of c as command line style arguments.
+func (c Check) Args() []string {
+	var args []string
+	if c.AllowErrVars {
+		args = append(args, "-allow-err-vars")
+	}
+	if c.AllowEmbeds {
+		args = append(args, "-allow-embeds")
+	}
+	for _, name := range c.AllowNames {
+		args = append(args, "-allow-name", name)
+	}
+	return args
+}
+
+// Check reports global variables in pkgs.
+func (c Check) Check(pkgs ...string) error {
+	var allowed []*regexp.Regexp
+	for _, name := range c.AllowNames {
+		re, err := regexp.Compile(name)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("invalid name pattern %s: %v", name, err))
+		}
+		allowed = append(allowed, re)
+	}
+	files, err := checkers.GoFiles(pkgs...)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, file := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to parse %s: %v", file, err))
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if c.AllowEmbeds && (isEmbed(gen.Doc) || isEmbed(vs.Doc)) {
+					continue
+				}
+				for i, name := range vs.Names {
+					if name.Name == "_" || matchesAny(allowed, name.Name) {
+						continue
+					}
+					if c.AllowErrVars && i < len(vs.Values) && isErrVar(name.Name, vs.Values[i]) {
+						continue
+					}
+					errs = append(errs, fmt.Sprintf("%s: %s is a global variable", fset.Position(name.Pos()), name.Name))
+				}
+			}
+		}
+	}
+	return checkers.Error(errs...)
+}
+
+func matchesAny(res []*regexp.Regexp, name string) bool {
+	for _, re := range res {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmbed returns true if doc holds a //go:embed directive.
+func isEmbed(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.HasPrefix(comment.Text, "//go:embed ") {
+			return true
+		}
+	}
+	return false
+}
+
+// isErrVar returns true if name is an error variable initialized using errors.New
+// or fmt.Errorf.
+func isErrVar(name string, value ast.Expr) bool {
+	if !strings.HasPrefix(name, "Err") && !strings.HasPrefix(name, "err") {
+		return false
+	}
+	call, ok := value.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	return pkg.Name == "errors" && sel.Sel.Name == "New" || pkg.Name == "fmt" && sel.Sel.Name == "Errorf"
+}
@@ -0,0 +1,94 @@
+package gochecknoglobals_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/gochecknoglobals"
+	"github.com/surullabs/lint/testutil"
+)
+
+const embedded = `package gochecknoglobalstest
+
+import _ "embed"
+
+//go:embed data.txt
+var data string
+
+// Data is a test function
+func Data() string { return data }
+`
+
+const sentinel = `package gochecknoglobalstest
+
+import "errors"
+
+// ErrMissing is a test error
+var ErrMissing = errors.New("missing")
+`
+
+const plain = `package gochecknoglobalstest
+
+var counter int
+
+// Next is a test function
+func Next() int {
+	counter++
+	return counter
+}
+`
+
+func TestGochecknoglobals(t *testing.T) {
+	files := map[string][]byte{"data.txt": []byte("data")}
+	testutil.Test(t, "gochecknoglobalstest", []testutil.StaticCheckTest{
+		{
+			Checker:  gochecknoglobals.Check{},
+			Content:  []byte(plain),
+			Validate: testutil.HasSuffix("file.go:3:5: counter is a global variable"),
+		},
+		{
+			Checker:  gochecknoglobals.Check{},
+			Content:  []byte(plain),
+			Validate: testutil.SkippedErrors(`is a global variable`),
+		},
+		{
+			Checker:  gochecknoglobals.Check{AllowNames: []string{"^count"}},
+			Content:  []byte(plain),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  gochecknoglobals.Check{AllowNames: []string{"("}},
+			Content:  []byte(plain),
+			Validate: testutil.Contains("invalid name pattern ("),
+		},
+		{
+			Checker:  gochecknoglobals.Check{},
+			Content:  []byte(embedded),
+			Files:    files,
+			Validate: testutil.HasSuffix("data is a global variable"),
+		},
+		{
+			Checker:  gochecknoglobals.Check{AllowEmbeds: true},
+			Content:  []byte(embedded),
+			Files:    files,
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  gochecknoglobals.Check{},
+			Content:  []byte(sentinel),
+			Validate: testutil.HasSuffix("ErrMissing is a global variable"),
+		},
+		{
+			Checker:  gochecknoglobals.Check{AllowErrVars: true},
+			Content:  []byte(sentinel),
+			Validate: testutil.NoError,
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gochecknoglobals.Check{}, Expected: nil},
+		{A: gochecknoglobals.Check{AllowErrVars: true, AllowEmbeds: true}, Expected: []string{"-allow-err-vars", "-allow-embeds"}},
+		{A: gochecknoglobals.Check{AllowNames: []string{"^version$", "^build"}}, Expected: []string{"-allow-name", "^version$", "-allow-name", "^build"}},
+	})
+}
@@ -0,0 +1,29 @@
+// Package gochecksumtype provides lint integration for the go-check-sumtype linter
+package gochecksumtype
+
+import (
+	"strconv"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the go-check-sumtype linter (https://github.com/alecthomas/go-check-sumtype)
+//
+// Sum types are interfaces declared with a //sumtype:decl comment. Type switches
+// on them must handle every implementing type.
+type Check struct {
+	// Command sets the environment and working directory used to run go-check-sumtype
+	checkers.Command
+	// DefaultSignifiesExhaustive treats a switch with a default case as exhaustive
+	DefaultSignifiesExhaustive bool
+}
+
+// Check runs go-check-sumtype and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "go-check-sumtype", "", "github.com/alecthomas/go-check-sumtype/cmd/go-check-sumtype", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for go-check-sumtype
+func (c Check) Args() []string {
+	return []string{"-default-signifies-exhaustive=" + strconv.FormatBool(c.DefaultSignifiesExhaustive)}
+}
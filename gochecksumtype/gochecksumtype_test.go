@@ -0,0 +1,103 @@
+package gochecksumtype_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/gochecksumtype"
+	"github.com/surullabs/lint/testutil"
+)
+
+// shape declares a sealed sum type with two variants.
+const shape = `package gochecksumtypetest
+
+//sumtype:decl
+type Shape interface{ sealed() }
+
+type Circle struct{}
+
+func (Circle) sealed() {}
+
+type Square struct{}
+
+func (Square) sealed() {}
+`
+
+func TestGoCheckSumtype(t *testing.T) {
+	testutil.Test(t, "gochecksumtypetest", []testutil.StaticCheckTest{
+		{
+			Checker: gochecksumtype.Check{},
+			Content: []byte(`package gochecksumtypetest
+
+// Name is a test function
+func Name(s Shape) string {
+	switch s.(type) {
+	case Circle:
+		return "circle"
+	case Square:
+		return "square"
+	}
+	return ""
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gochecksumtype.Check{},
+			Content: []byte(`package gochecksumtypetest
+
+// Name is a test function
+func Name(s Shape) string {
+	switch s.(type) {
+	case Circle:
+		return "circle"
+	}
+	return ""
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.Contains("exhaustiveness check failed for sum type"),
+		},
+		{
+			Checker: gochecksumtype.Check{},
+			Content: []byte(`package gochecksumtypetest
+
+// Name is a test function
+func Name(s Shape) string {
+	switch s.(type) {
+	case Circle:
+		return "circle"
+	}
+	return ""
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.SkippedErrors(`exhaustiveness check failed`),
+		},
+		{
+			Checker: gochecksumtype.Check{DefaultSignifiesExhaustive: true},
+			Content: []byte(`package gochecksumtypetest
+
+// Name is a test function
+func Name(s Shape) string {
+	switch s.(type) {
+	case Circle:
+		return "circle"
+	default:
+		return "other"
+	}
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.NoError,
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gochecksumtype.Check{}, Expected: []string{"-default-signifies-exhaustive=false"}},
+		{A: gochecksumtype.Check{DefaultSignifiesExhaustive: true}, Expected: []string{"-default-signifies-exhaustive=true"}},
+	})
+}
@@ -0,0 +1,69 @@
+// Package gocognit provides lint integration for the gocognit linter
+package gocognit
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the gocognit linter (https://github.com/uudashr/gocognit) to report
+// functions with a high cognitive complexity.
+type Check struct {
+	// Command sets the environment and working directory used to run gocognit
+	checkers.Command
+	// Threshold is the complexity above which functions are reported. It defaults
+	// to 30 if it is 0.
+	Threshold int
+}
+
+// statRE matches a line of gocognit output of the form
+//   <complexity> <package> <function> <file:line:col>
+var statRE = regexp.MustCompile(`^([0-9]+) (\S+) (.+) (\S+:[0-9]+:[0-9]+)$`)
+
+// Check runs
+//   gocognit -over <threshold> <files>
+//
+// for all files in pkgs.
+func (c Check) Check(pkgs ...string) error {
+	files, err := checkers.GoFiles(pkgs...)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	bin, err := checkers.InstallMissing("gocognit", "github.com/uudashr/gocognit/cmd/gocognit", "github.com/uudashr/gocognit/cmd/gocognit")
+	if err != nil {
+		return err
+	}
+	res, err := checkers.Exec(checkers.Cmd(c.Command, bin, append(c.Args(), files...)...))
+	if err != nil && strings.TrimSpace(res.Stdout) == "" {
+		return checkers.Operational(fmt.Errorf("gocognit failed: %v: %s", err, strings.TrimSpace(res.Stderr)))
+	}
+	var errs []string
+	for _, line := range checkers.OutputLines(res.Stdout) {
+		m := statRE.FindStringSubmatch(line)
+		if m == nil {
+			errs = append(errs, line)
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s: cognitive complexity %s of func %s is high (> %d)", m[4], m[1], m[3], c.threshold()))
+	}
+	return checkers.Error(errs...)
+}
+
+func (c Check) threshold() int {
+	if c.Threshold == 0 {
+		return 30
+	}
+	return c.Threshold
+}
+
+// Args returns command line arguments used for gocognit
+func (c Check) Args() []string {
+	return []string{"-over", strconv.Itoa(c.threshold())}
+}
@@ -0,0 +1,65 @@
+package gocognit_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/gocognit"
+	"github.com/surullabs/lint/testutil"
+)
+
+const complex = `package gocognittest
+
+// Classify is a test function
+func Classify(values []int, strict bool) int {
+	count := 0
+	for _, v := range values {
+		if v > 0 && (strict || v%2 == 0) {
+			if v > 10 || v < 100 && !strict {
+				count++
+			} else if v == 5 {
+				count--
+			}
+		}
+	}
+	return count
+}
+`
+
+func TestGocognit(t *testing.T) {
+	testutil.Test(t, "gocognittest", []testutil.StaticCheckTest{
+		{
+			Checker: gocognit.Check{},
+			Content: []byte(`package gocognittest
+
+// Add is a test function
+func Add(a, b int) int {
+	return a + b
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  gocognit.Check{},
+			Content:  []byte(complex),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  gocognit.Check{Threshold: 5},
+			Content:  []byte(complex),
+			Validate: testutil.MatchesRegexp(`file.go:4:1: cognitive complexity [0-9]+ of func Classify is high \(> 5\)$`),
+		},
+		{
+			Checker:  gocognit.Check{Threshold: 5},
+			Content:  []byte(complex),
+			Validate: testutil.SkippedErrors(`cognitive complexity [0-9]+ of func Classify`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gocognit.Check{}, Expected: []string{"-over", "30"}},
+		{A: gocognit.Check{Threshold: 10}, Expected: []string{"-over", "10"}},
+	})
+}
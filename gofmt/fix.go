@@ -0,0 +1,41 @@
+package gofmt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Fix implements lint.Fixer for gofmt. It runs
+//   gofmt -l <files>
+//
+// for all files in pkg and rewrites each listed file with its formatted contents.
+func (c Check) Fix(pkg string) ([]string, error) {
+	files, err := checkers.GoFiles(pkg)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+	data, err := checkers.Cmd(c.Command, "gofmt", append([]string{"-l"}, files...)...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %s", err, string(data))
+	}
+	var fixed []string
+	for _, file := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if file == "" {
+			continue
+		}
+		formatted, err := checkers.Cmd(c.Command, "gofmt", file).Output()
+		if err != nil {
+			return fixed, fmt.Errorf("failed to format %s: %v", file, err)
+		}
+		if err = checkers.WriteFile(file, formatted); err != nil {
+			return fixed, err
+		}
+		fixed = append(fixed, file)
+	}
+	return fixed, nil
+}
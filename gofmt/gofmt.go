@@ -1,32 +1,80 @@
 package gofmt
 
 import (
-	"bytes"
 	"fmt"
 	"github.com/surullabs/lint/checkers"
-	"os/exec"
+	"strings"
 )
 
 // Check is implements lint.Checker for gofmt.
 type Check struct {
+	// Command sets the environment and working directory used to run gofmt
+	checkers.Command
+	// MaxDiffLines limits the size of the diff reported for each file. If the diff
+	// for a file has more lines, it is replaced by a single line noting that the
+	// file needs formatting. Diffs are not limited if it is 0.
+	MaxDiffLines int
 }
 
 // Check runs
 //   gofmt -d <files>
 //
 // for all files in pkgs.
-func (Check) Check(pkgs ...string) error {
+func (c Check) Check(pkgs ...string) error {
 	files, err := checkers.GoFiles(pkgs...)
 	if err != nil {
 		return err
 	}
-	data, err := exec.Command("gofmt", append([]string{"-d"}, files...)...).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("%v: %s", err, string(data))
+	res, err := checkers.Exec(checkers.Cmd(c.Command, "gofmt", append([]string{"-d"}, files...)...))
+	if stderr := strings.TrimSpace(res.Stderr); err != nil && (stderr != "" || res.Code != 1) {
+		// gofmt fails if files cannot be parsed, which prevents formatting checks.
+		return checkers.Operational(fmt.Errorf("%v: %s", err, stderr))
+	}
+	str := strings.TrimSpace(res.Stdout)
+	if len(str) == 0 {
+		return nil
+	}
+	if c.MaxDiffLines > 0 {
+		str = c.truncate(str, files)
+	}
+	return fmt.Errorf("File not formatted: %s", str)
+}
+
+// Name returns gofmt.Check, the name used to identify c in a Group.
+func (c Check) Name() string { return "gofmt.Check" }
+
+// truncate replaces the diff of each file in diff which is longer than MaxDiffLines
+// with a summary line.
+func (c Check) truncate(diff string, files []string) string {
+	var chunks [][]string
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "diff ") || len(chunks) == 0 {
+			chunks = append(chunks, nil)
+		}
+		chunks[len(chunks)-1] = append(chunks[len(chunks)-1], line)
+	}
+	var out []string
+	for _, chunk := range chunks {
+		if len(chunk) <= c.MaxDiffLines {
+			out = append(out, chunk...)
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s: needs formatting (diff truncated, %d lines)", diffFile(chunk[0], files), len(chunk)))
+	}
+	return strings.Join(out, "\n")
+}
+
+// diffFile returns the file in files referenced by the header of a diff, or the
+// header itself if none match.
+func diffFile(header string, files []string) string {
+	file := ""
+	for _, f := range files {
+		if strings.Contains(header, f) && len(f) > len(file) {
+			file = f
+		}
 	}
-	str := bytes.TrimSpace(data)
-	if len(str) > 0 {
-		return fmt.Errorf("File not formatted: %s", string(str))
+	if file == "" {
+		return header
 	}
-	return nil
+	return file
 }
@@ -1,8 +1,14 @@
 package gofmt_test
 
 import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
 	"testing"
 
+	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
 	"github.com/surullabs/lint/gofmt"
 	"github.com/surullabs/lint/testutil"
 )
@@ -52,6 +58,102 @@ type A struct {
 	})
 }
 
+// smallDiff is reported using a diff of 10 lines.
+const smallDiff = `package gofmttest
+
+func TestFunc() {
+  println("one")
+}
+`
+
+func TestMaxDiffLines(t *testing.T) {
+	testutil.Test(t, "gofmttest", []testutil.StaticCheckTest{
+		{
+			Checker:  gofmt.Check{MaxDiffLines: 10},
+			Content:  []byte(smallDiff),
+			Validate: testutil.Contains("+\tprintln(\"one\")"),
+		},
+		{
+			Checker:  gofmt.Check{MaxDiffLines: 9},
+			Content:  []byte(smallDiff),
+			Validate: testutil.MatchesRegexp(`^File not formatted: .*gofmttest/file.go: needs formatting \(diff truncated, 10 lines\)$`),
+		},
+		{
+			Checker: gofmt.Check{MaxDiffLines: 20},
+			Content: []byte(`package gofmttest
+
+func TestFunc() {
+  println("one")
+  println("two")
+  println("three")
+  println("four")
+  println("five")
+  println("six")
+  println("seven")
+  println("eight")
+  println("nine")
+  println("ten")
+}
+`),
+			Validate: testutil.MatchesRegexp(`^File not formatted: .*gofmttest/file.go: needs formatting \(diff truncated, 2[0-9] lines\)$`),
+		},
+	})
+}
+
+const unformatted = `package gofmtfix
+
+func TestFunc() {
+  println("This is a poorly formatted file")
+}
+`
+
+const formatted = `package gofmtfix
+
+func TestFunc() {
+	println("This is a properly formatted file")
+}
+`
+
+func TestFix(t *testing.T) {
+	checkers.Unload("gofmtfix")
+	tmp, err := fakegopath.NewTemporaryWithFiles("gofmtfix", []fakegopath.SourceFile{
+		{Content: []byte(unformatted), Dest: filepath.Join("gofmtfix", "bad.go")},
+		{Content: []byte(formatted), Dest: filepath.Join("gofmtfix", "good.go")},
+	})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+
+	var fixer lint.Fixer = gofmt.Check{}
+	fixed, err := fixer.Fix("gofmtfix")
+	if err != nil {
+		t.Fatalf("fix failed: %v", err)
+	}
+	if len(fixed) != 1 || filepath.Base(fixed[0]) != "bad.go" {
+		t.Fatalf("expected only bad.go to be fixed, got %v", fixed)
+	}
+	data, err := ioutil.ReadFile(fixed[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `package gofmtfix
+
+func TestFunc() {
+	println("This is a poorly formatted file")
+}
+`
+	if string(data) != expected {
+		t.Errorf("file not formatted:\n%s", string(data))
+	}
+	if err := (gofmt.Check{}).Check("gofmtfix"); err != nil {
+		t.Errorf("expected no errors after fix, got %v", err)
+	}
+	if fixed, err = fixer.Fix("gofmtfix"); err != nil || !reflect.DeepEqual(fixed, []string(nil)) {
+		t.Errorf("expected no files to be fixed, got %v, %v", fixed, err)
+	}
+}
+
 const expectedUnformatted = `File not formatted: diff GOFMT_TMP_FOLDER
 --- GOFMT_TMP_FOLDER
 +++ GOFMT_TMP_FOLDER
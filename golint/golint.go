@@ -4,9 +4,14 @@ import "github.com/surullabs/lint/checkers"
 
 // Check implements a golint Checker
 type Check struct {
+	// Command sets the environment and working directory used to run golint
+	checkers.Command
 }
 
 // Check implements lint.Checker for golint.
-func (Check) Check(pkgs ...string) error {
-	return checkers.Lint("golint", "", "github.com/golang/lint/golint", pkgs)
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "golint", "", "github.com/golang/lint/golint", pkgs)
 }
+
+// Name returns golint.Check, the name used to identify c in a Group.
+func (c Check) Name() string { return "golint.Check" }
@@ -16,6 +16,9 @@ import (
 // arguments passed to gometalinter. Do not include directory names in Args. These
 // will be added automatically, based on the arguments to Check(pkgs).
 type Check struct {
+	// Command sets the environment and working directory used to run gometalinter.
+	// The environment is applied after that required to run the vendored linters.
+	checkers.Command
 	Args []string
 }
 
@@ -35,16 +38,16 @@ func (c Check) Check(pkgs ...string) error {
 			dirs[i] = filepath.Join(dirs[i], "...")
 		}
 	}
-	return runMetalinter(append(c.Args, dirs...)...)
+	return runMetalinter(c.Command, append(c.Args, dirs...)...)
 }
 
-func runMetalinter(args ...string) error {
+func runMetalinter(c checkers.Command, args ...string) error {
 	env, bin, err := installMetaLinter()
 	if err != nil {
 		return err
 	}
-	cmd := exec.Command(bin, args...)
-	cmd.Env = env
+	cmd := checkers.Cmd(c, bin, args...)
+	cmd.Env = append(env, c.Env...)
 	r, err := checkers.Exec(cmd)
 	// From the gometalinter README it sets two bits of information in the error code.
 	// So any error code from 1 - 3 is a metalinter error which we pass on. Any other
@@ -0,0 +1,87 @@
+// Package gomoddirectives provides a lint check for replace directives in go.mod files.
+package gomoddirectives
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+	"golang.org/x/mod/modfile"
+)
+
+// Check reports replace directives in the go.mod files of the modules containing
+// the checked packages. Replacements of modules not in ReplaceAllowList are
+// reported if ReplaceAllowList is non-empty. Replacements with a local directory
+// are reported unless ReplaceLocal is set.
+type Check struct {
+	// ReplaceAllowList holds the module paths which may be replaced. If empty, any
+	// module may be replaced.
+	ReplaceAllowList []string
+	// ReplaceLocal allows replacements with a local directory.
+	ReplaceLocal bool
+}
+
+// Args returns the settings of c as command line style arguments.
+func (c Check) Args() []string {
+	var args []string
+	if len(c.ReplaceAllowList) > 0 {
+		args = append(args, "-replace-allow-list", strings.Join(c.ReplaceAllowList, ","))
+	}
+	if c.ReplaceLocal {
+		args = append(args, "-replace-local")
+	}
+	return args
+}
+
+// Check checks the go.mod files of the modules containing pkgs.
+func (c Check) Check(pkgs ...string) error {
+	var errs []string
+	seen := map[string]bool{}
+	for _, pkg := range pkgs {
+		p, err := checkers.Load(pkg)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+		}
+		root, err := checkers.ModuleRoot(p.Build.Dir)
+		if err != nil {
+			return checkers.Operational(err)
+		}
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		modErrs, err := c.checkModule(filepath.Join(root, "go.mod"))
+		if err != nil {
+			return err
+		}
+		errs = append(errs, modErrs...)
+	}
+	return checkers.Error(errs...)
+}
+
+func (c Check) checkModule(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, checkers.Operational(err)
+	}
+	f, err := modfile.Parse(path, data, nil)
+	if err != nil {
+		return nil, checkers.Operational(err)
+	}
+	allowed := map[string]bool{}
+	for _, mod := range c.ReplaceAllowList {
+		allowed[mod] = true
+	}
+	var errs []string
+	for _, r := range f.Replace {
+		switch {
+		case !c.ReplaceLocal && modfile.IsDirectoryPath(r.New.Path):
+			errs = append(errs, fmt.Sprintf("%s:%d: local replacement are not allowed: %s", path, r.Syntax.Start.Line, r.Old.Path))
+		case len(allowed) > 0 && !allowed[r.Old.Path]:
+			errs = append(errs, fmt.Sprintf("%s:%d: replacement are not allowed: %s", path, r.Syntax.Start.Line, r.Old.Path))
+		}
+	}
+	return errs, nil
+}
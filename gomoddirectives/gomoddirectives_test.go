@@ -0,0 +1,89 @@
+package gomoddirectives_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/gomoddirectives"
+	"github.com/surullabs/lint/testutil"
+)
+
+const source = `package gomoddirectivestest
+
+import "fmt"
+
+// TestFunc is a test function
+func TestFunc() {
+	fmt.Println("replaced")
+}
+`
+
+const localReplace = `module gomoddirectivestest
+
+go 1.21
+
+require example.com/x v1.0.0
+
+replace example.com/x => ../x
+`
+
+func TestGoModDirectives(t *testing.T) {
+	testutil.Test(t, "gomoddirectivestest", []testutil.StaticCheckTest{
+		{
+			Checker: gomoddirectives.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"go.mod": []byte("module gomoddirectivestest\n\ngo 1.21\n"),
+			},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gomoddirectives.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"go.mod": []byte(localReplace),
+			},
+			Validate: testutil.HasSuffix("go.mod:7: local replacement are not allowed: example.com/x"),
+		},
+		{
+			Checker: gomoddirectives.Check{ReplaceLocal: true},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"go.mod": []byte(localReplace),
+			},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gomoddirectives.Check{ReplaceLocal: true, ReplaceAllowList: []string{"example.com/y"}},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"go.mod": []byte(localReplace),
+			},
+			Validate: testutil.HasSuffix("go.mod:7: replacement are not allowed: example.com/x"),
+		},
+		{
+			Checker: gomoddirectives.Check{},
+			Content: []byte(source),
+			Validate: func(err error) error {
+				findings, ops := lint.Split(err)
+				if findings != nil || ops == nil || !strings.Contains(ops.Error(), "no go.mod found") {
+					return fmt.Errorf("expected an operational error, got %v", err)
+				}
+				return nil
+			},
+		},
+	})
+}
+
+func TestGoModDirectivesArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gomoddirectives.Check{}, Expected: nil},
+		{A: gomoddirectives.Check{ReplaceLocal: true}, Expected: []string{"-replace-local"}},
+		{
+			A:        gomoddirectives.Check{ReplaceAllowList: []string{"example.com/x", "example.com/y"}},
+			Expected: []string{"-replace-allow-list", "example.com/x,example.com/y"},
+		},
+	})
+}
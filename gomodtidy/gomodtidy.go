@@ -0,0 +1,66 @@
+// Package gomodtidy provides a lint check that verifies go.mod and go.sum are tidy.
+package gomodtidy
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check verifies that the modules containing the checked packages are tidy. It runs
+//   go mod tidy -diff
+//
+// in each module root, which reports the changes go mod tidy would make without
+// modifying go.mod or go.sum. This requires Go 1.23 or later.
+type Check struct {
+	// Command sets the environment used to run go mod tidy. GOFLAGS is cleared
+	// unless set in Env. WorkDir is not used, as go mod tidy is always run in
+	// the module root.
+	checkers.Command
+}
+
+// Check runs go mod tidy -diff for the modules containing pkgs.
+func (c Check) Check(pkgs ...string) error {
+	var errs []string
+	seen := map[string]bool{}
+	for _, pkg := range pkgs {
+		p, err := checkers.Load(pkg)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+		}
+		root, err := checkers.ModuleRoot(p.Build.Dir)
+		if err != nil {
+			return checkers.Operational(err)
+		}
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		found, err := checkModule(c.Command, root)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, found...)
+	}
+	return checkers.Error(errs...)
+}
+
+// checkModule returns the changes go mod tidy would make to the module at root. An
+// OperationalError is returned if go mod tidy fails to run, as done by linters
+// run using checkers.LintCommand.
+func checkModule(c checkers.Command, root string) ([]string, error) {
+	c.Env = append([]string{"GO111MODULE=on", "GOFLAGS="}, c.Env...)
+	c.WorkDir = root
+	cmd := checkers.Cmd(c, "go", "mod", "tidy", "-diff")
+	res, err := checkers.Exec(cmd)
+	if err == nil {
+		return nil, nil
+	}
+	if res.Code != 1 || strings.TrimSpace(res.Stdout) == "" {
+		return nil, checkers.Operational(fmt.Errorf("go mod tidy failed: %s: %v: %s", root, err, strings.TrimSpace(res.Stderr)))
+	}
+	return []string{fmt.Sprintf("%s: module needs tidying:\n%s",
+		filepath.Join(root, "go.mod"), strings.TrimSpace(res.Stdout))}, nil
+}
@@ -0,0 +1,67 @@
+package gomodtidy_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+	"github.com/surullabs/lint/gomodtidy"
+	"github.com/surullabs/lint/testutil"
+)
+
+const source = `package gomodtidytest
+
+import "fmt"
+
+// TestFunc is a test function
+func TestFunc() {
+	fmt.Println("tidy")
+}
+`
+
+func TestGoModTidy(t *testing.T) {
+	testutil.Test(t, "gomodtidytest", []testutil.StaticCheckTest{
+		{
+			Checker: gomodtidy.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"go.mod": []byte("module gomodtidytest\n\ngo 1.21\n"),
+			},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gomodtidy.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"go.mod": []byte("module gomodtidytest\n\ngo 1.21\n\nrequire github.com/mibk/dupl v1.1.0\n"),
+			},
+			Validate: testutil.MatchesRegexp(`go\.mod: module needs tidying:\n(.|\n)*-require github.com/mibk/dupl v1\.1\.0`),
+		},
+		{
+			Checker: gomodtidy.Check{},
+			Content: []byte(source),
+			Validate: func(err error) error {
+				findings, ops := lint.Split(err)
+				if findings != nil || ops == nil || !strings.Contains(ops.Error(), "no go.mod found") {
+					return fmt.Errorf("expected an operational error, got %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Checker: gomodtidy.Check{Command: checkers.Command{Env: []string{"GOFLAGS=-unknownflag"}}},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"go.mod": []byte("module gomodtidytest\n\ngo 1.21\n"),
+			},
+			Validate: func(err error) error {
+				if _, ok := err.(checkers.OperationalError); !ok || !strings.Contains(err.Error(), "unknownflag") {
+					return fmt.Errorf("expected an operational error, got %v", err)
+				}
+				return nil
+			},
+		},
+	})
+}
@@ -0,0 +1,201 @@
+// Package gosec provides lint integration for the gosec linter
+package gosec
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the gosec linter (https://github.com/securego/gosec). Findings are
+// reported as
+//   file:line:col: [G104] Errors unhandled. (Confidence: HIGH, Severity: LOW)
+type Check struct {
+	// Command sets the environment and working directory used to run gosec
+	checkers.Command
+	// ReportUnusedNosec reports #nosec annotations which do not suppress any
+	// finding, as
+	//   file:line: unused #nosec annotation
+	//
+	// When set, gosec is run with -nosec and annotations are applied to the
+	// findings on the same line. An annotation listing rules, such as #nosec G104,
+	// only applies to those rules.
+	ReportUnusedNosec bool
+	// ExcludeGenerated skips files marked as generated, which contain a comment of
+	// the form
+	//   // Code generated ... DO NOT EDIT.
+	ExcludeGenerated bool
+}
+
+// issue is a single finding in the JSON output of gosec.
+type issue struct {
+	Severity   string `json:"severity"`
+	Confidence string `json:"confidence"`
+	RuleID     string `json:"rule_id"`
+	Details    string `json:"details"`
+	File       string `json:"file"`
+	Line       string `json:"line"`
+	Column     string `json:"column"`
+}
+
+// Check runs gosec for pkgs and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	var dirs, files []string
+	for _, pkg := range pkgs {
+		p, err := checkers.LoadDir(c.WorkDir, pkg)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+		}
+		dirs = append(dirs, p.Dirs...)
+		files = append(files, p.GoFiles...)
+	}
+	if len(dirs) == 0 {
+		return nil
+	}
+	bin, err := checkers.InstallMissing("gosec", "github.com/securego/gosec/v2/cmd/gosec", "github.com/securego/gosec/v2/cmd/gosec")
+	if err != nil {
+		return err
+	}
+	res, err := checkers.Exec(checkers.Cmd(c.Command, bin, append(c.Args(), dirs...)...))
+	var out struct {
+		Issues []issue
+	}
+	if jerr := json.Unmarshal([]byte(res.Stdout), &out); jerr != nil {
+		return checkers.Operational(fmt.Errorf("gosec failed: %v: %s", err, strings.TrimSpace(res.Stderr)))
+	}
+	var nosecs []*nosec
+	if c.ReportUnusedNosec {
+		if nosecs, err = findNosec(files, c.ExcludeGenerated); err != nil {
+			return checkers.Operational(err)
+		}
+	}
+	var errs []string
+	for _, i := range out.Issues {
+		if suppressed(nosecs, i) {
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s:%s:%s: [%s] %s (Confidence: %s, Severity: %s)",
+			i.File, startLine(i.Line), i.Column, i.RuleID, i.Details, i.Confidence, i.Severity))
+	}
+	for _, n := range nosecs {
+		if !n.used {
+			errs = append(errs, fmt.Sprintf("%s:%d: unused #nosec annotation", n.file, n.line))
+		}
+	}
+	return checkers.Error(errs...)
+}
+
+// Args returns command line arguments used for gosec
+func (c Check) Args() []string {
+	args := []string{"-fmt=json"}
+	if c.ReportUnusedNosec {
+		args = append(args, "-nosec")
+	}
+	if c.ExcludeGenerated {
+		args = append(args, "-exclude-generated")
+	}
+	return args
+}
+
+// nosec is a #nosec annotation in a source file.
+type nosec struct {
+	file  string
+	line  int
+	rules []string
+	used  bool
+}
+
+// nosecRE matches a #nosec annotation, along with any rules it is limited to.
+var nosecRE = regexp.MustCompile(`#nosec((?:\s+G[0-9]+)*)`)
+
+// generatedRE matches the comment marking a file as generated.
+var generatedRE = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// findNosec returns the #nosec annotations in files. Generated files are skipped
+// if skipGenerated is true, since gosec does not report issues for them.
+func findNosec(files []string, skipGenerated bool) ([]*nosec, error) {
+	var found []*nosec
+	for _, file := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+		}
+		if skipGenerated && isGenerated(f) {
+			continue
+		}
+		for _, group := range f.Comments {
+			for _, comment := range group.List {
+				if m := nosecRE.FindStringSubmatch(comment.Text); m != nil {
+					found = append(found, &nosec{
+						file:  file,
+						line:  fset.Position(comment.Pos()).Line,
+						rules: strings.Fields(m[1]),
+					})
+				}
+			}
+		}
+	}
+	return found, nil
+}
+
+// isGenerated returns true if f has a comment marking it as generated before the
+// package clause.
+func isGenerated(f *ast.File) bool {
+	for _, group := range f.Comments {
+		if group.Pos() >= f.Package {
+			break
+		}
+		for _, comment := range group.List {
+			if generatedRE.MatchString(comment.Text) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// suppressed returns true if an annotation in nosecs applies to i, marking it used.
+func suppressed(nosecs []*nosec, i issue) bool {
+	line, err := strconv.Atoi(startLine(i.Line))
+	if err != nil {
+		return false
+	}
+	for _, n := range nosecs {
+		if n.line != line || !sameFile(n.file, i.File) {
+			continue
+		}
+		if len(n.rules) == 0 || contains(n.rules, i.RuleID) {
+			n.used = true
+			return true
+		}
+	}
+	return false
+}
+
+// startLine returns the first line of a line range, such as 12-14, reported by gosec.
+func startLine(lines string) string {
+	return strings.SplitN(lines, "-", 2)[0]
+}
+
+// sameFile returns true if the file listed by the checkers package, which may be
+// relative, is the file reported by gosec.
+func sameFile(listed, reported string) bool {
+	return listed == reported || strings.HasSuffix(reported, "/"+strings.TrimPrefix(listed, "./"))
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
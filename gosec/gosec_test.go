@@ -0,0 +1,121 @@
+package gosec_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/gosec"
+	"github.com/surullabs/lint/testutil"
+)
+
+const unhandled = `package gosectest
+
+import "os"
+
+// Clean is a test function
+func Clean() {
+	os.Remove("/tmp/gosectest")
+}
+`
+
+const clean = `package gosectest
+
+import "os"
+
+// Clean is a test function
+func Clean() error {
+	return os.Remove("/tmp/gosectest")
+}
+`
+
+var generated = map[string][]byte{"gen.go": []byte(`// Code generated by gentest. DO NOT EDIT.
+
+package gosectest
+
+import "os"
+
+// Generated is a test function
+func Generated() {
+	os.Remove("/tmp/gosectest")
+}
+`)}
+
+var generatedNosec = map[string][]byte{"gen.go": []byte(`// Code generated by gentest. DO NOT EDIT.
+
+package gosectest
+
+// Triple is a test function
+func Triple(x int) int {
+	return 3 * x // #nosec
+}
+`)}
+
+func TestGosec(t *testing.T) {
+	testutil.Test(t, "gosectest", []testutil.StaticCheckTest{
+		{
+			Checker:  gosec.Check{},
+			Content:  []byte(clean),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  gosec.Check{},
+			Content:  []byte(unhandled),
+			Validate: testutil.MatchesRegexp(`file\.go:7:2: \[G104\] Errors unhandled\.? \(Confidence: HIGH, Severity: LOW\)`),
+		},
+		{
+			Checker:  gosec.Check{},
+			Content:  []byte(unhandled),
+			Validate: testutil.SkippedErrors(`\[G104\]`),
+		},
+		{
+			Checker: gosec.Check{ReportUnusedNosec: true},
+			Content: []byte(`package gosectest
+
+import "os"
+
+// Clean is a test function
+func Clean() {
+	os.Remove("/tmp/gosectest") // #nosec G104
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gosec.Check{ReportUnusedNosec: true},
+			Content: []byte(`package gosectest
+
+// Double is a test function
+func Double(x int) int {
+	return 2 * x // #nosec
+}
+`),
+			Validate: testutil.HasSuffix("file.go:5: unused #nosec annotation"),
+		},
+		{
+			Checker:  gosec.Check{},
+			Content:  []byte(clean),
+			Files:    generated,
+			Validate: testutil.MatchesRegexp(`gen\.go:9:2: \[G104\]`),
+		},
+		{
+			Checker:  gosec.Check{ExcludeGenerated: true},
+			Content:  []byte(clean),
+			Files:    generated,
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  gosec.Check{ReportUnusedNosec: true, ExcludeGenerated: true},
+			Content:  []byte(clean),
+			Files:    generatedNosec,
+			Validate: testutil.NoError,
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gosec.Check{}, Expected: []string{"-fmt=json"}},
+		{A: gosec.Check{ReportUnusedNosec: true}, Expected: []string{"-fmt=json", "-nosec"}},
+		{A: gosec.Check{ExcludeGenerated: true}, Expected: []string{"-fmt=json", "-exclude-generated"}},
+		{A: gosec.Check{ReportUnusedNosec: true, ExcludeGenerated: true}, Expected: []string{"-fmt=json", "-nosec", "-exclude-generated"}},
+	})
+}
@@ -7,9 +7,20 @@ import (
 
 // Check implements a gosimple Checker (https://github.com/dominikh/go-simple)
 type Check struct {
+	// Command sets the environment and working directory used to run gosimple
+	checkers.Command
 }
 
 // Check runs gosimple for pkg
-func (Check) Check(pkgs ...string) error {
-	return checkers.Lint("gosimple", "", "honnef.co/go/simple/cmd/gosimple", pkgs)
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "gosimple", "", "honnef.co/go/simple/cmd/gosimple", pkgs)
+}
+
+// Name returns gosimple.Check, the name used to identify c in a Group.
+func (c Check) Name() string { return "gosimple.Check" }
+
+// DocsURL returns the URL documenting a gosimple check, such as S1000. It can be
+// used with lint.WithDocsURL.
+func DocsURL(check string) string {
+	return "https://staticcheck.io/docs/checks#" + check
 }
@@ -0,0 +1,36 @@
+// Package gosmopolitan provides lint integration for the gosmopolitan linter
+package gosmopolitan
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the gosmopolitan linter (https://github.com/xen0n/gosmopolitan)
+type Check struct {
+	// Command sets the environment and working directory used to run gosmopolitan
+	checkers.Command
+	// WatchForScripts is a list of Unicode script names to report in string
+	// literals. gosmopolitan defaults to Han if it is empty.
+	WatchForScripts []string
+	// AllowTimeLocal disables reporting usages of time.Local
+	AllowTimeLocal bool
+}
+
+// Check runs gosmopolitan and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "gosmopolitan", "", "github.com/xen0n/gosmopolitan/cmd/gosmopolitan", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for gosmopolitan
+func (c Check) Args() []string {
+	var args []string
+	if len(c.WatchForScripts) > 0 {
+		args = append(args, "-watchforscripts", strings.Join(c.WatchForScripts, ","))
+	}
+	if c.AllowTimeLocal {
+		args = append(args, "-allowtimelocal")
+	}
+	return args
+}
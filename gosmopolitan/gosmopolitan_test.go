@@ -0,0 +1,71 @@
+package gosmopolitan_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/gosmopolitan"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestGosmopolitan(t *testing.T) {
+	testutil.Test(t, "gosmopolitantest", []testutil.StaticCheckTest{
+		{
+			Checker: gosmopolitan.Check{},
+			Content: []byte(`package gosmopolitantest
+
+// Greeting is a test function
+func Greeting() string {
+	return "hello world"
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: gosmopolitan.Check{},
+			Content: []byte(`package gosmopolitantest
+
+// Greeting is a test function
+func Greeting() string {
+	return "你好，世界"
+}
+`),
+			Validate: testutil.Contains("string literal contains rune in Han script"),
+		},
+		{
+			Checker: gosmopolitan.Check{},
+			Content: []byte(`package gosmopolitantest
+
+import "time"
+
+// Now is a test function
+func Now() time.Time {
+	return time.Now().In(time.Local)
+}
+`),
+			Validate: testutil.Contains("usage of time.Local"),
+		},
+		{
+			Checker: gosmopolitan.Check{AllowTimeLocal: true},
+			Content: []byte(`package gosmopolitantest
+
+import "time"
+
+// Now is a test function
+func Now() time.Time {
+	return time.Now().In(time.Local)
+}
+`),
+			Validate: testutil.NoError,
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: gosmopolitan.Check{}, Expected: nil},
+		{A: gosmopolitan.Check{WatchForScripts: []string{"Han"}}, Expected: []string{"-watchforscripts", "Han"}},
+		{A: gosmopolitan.Check{WatchForScripts: []string{"Han", "Hangul"}}, Expected: []string{"-watchforscripts", "Han,Hangul"}},
+		{A: gosmopolitan.Check{AllowTimeLocal: true}, Expected: []string{"-allowtimelocal"}},
+	})
+}
@@ -7,9 +7,20 @@ import (
 
 // Check implements a gostaticcheck Checker (https://github.com/dominikh/go-staticcheck)
 type Check struct {
+	// Command sets the environment and working directory used to run staticcheck
+	checkers.Command
 }
 
 // Check runs gostaticcheck for pkgs
-func (Check) Check(pkgs ...string) error {
-	return checkers.Lint("staticcheck", "", "honnef.co/go/staticcheck/cmd/staticcheck", pkgs)
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "staticcheck", "", "honnef.co/go/staticcheck/cmd/staticcheck", pkgs)
+}
+
+// Name returns gostaticcheck.Check, the name used to identify c in a Group.
+func (c Check) Name() string { return "gostaticcheck.Check" }
+
+// DocsURL returns the URL documenting a staticcheck check, such as SA1000. It can
+// be used with lint.WithDocsURL.
+func DocsURL(check string) string {
+	return "https://staticcheck.io/docs/checks#" + check
 }
@@ -1,7 +1,8 @@
 package govet
 
 import (
-	"os/exec"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/surullabs/lint/checkers"
@@ -9,63 +10,106 @@ import (
 
 // Check implements a lint.Checker for the govet command.
 type Check struct {
+	// Command sets the environment and working directory used to run go vet
+	checkers.Command
 	Args []string
+	// Enable holds the names of vet analyzers to enable, such as shadow. Each is
+	// passed to vet as -<name>.
+	Enable []string
+	// Disable holds the names of vet analyzers to disable, such as printf. Each is
+	// passed to vet as -<name>=false.
+	Disable []string
 }
 
 // Shadow is a Checker that runs
 // 	 go tool vet --all --shadow.
 var Shadow = Check{Args: []string{"--all", "--shadow"}}
 
-// Check runs go tool vet for pkgs.
+// Flags returns the command line arguments passed to vet, which are Args followed
+// by the flags for Enable and Disable.
+func (c Check) Flags() []string {
+	flags := append([]string{}, c.Args...)
+	for _, name := range c.Enable {
+		flags = append(flags, "-"+name)
+	}
+	for _, name := range c.Disable {
+		flags = append(flags, "-"+name+"=false")
+	}
+	return flags
+}
+
+// Check runs go tool vet for pkgs. Errors vet reports when a package fails to
+// load or type check, such as a compile error, are returned as a
+// checkers.OperationalError.
 func (c Check) Check(pkgs ...string) error {
 	var errs []string
 	for _, pkg := range pkgs {
 		// Check files per package. If all files for all packages are
 		// passed in as a glob, it causes incorrect reports as described
 		// in TestGoVetMultiPackage_Issue7. Instead run go vet for each package.
-		errs = append(errs, c.checkPackage(pkg)...)
+		found, err := c.checkPackage(pkg)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, found...)
 	}
 	return checkers.Error(errs...)
 }
 
-func (c Check) checkPackage(pkg string) []string {
+// Name returns govet.Check, the name used to identify c in a Group.
+func (c Check) Name() string { return "govet.Check" }
+
+func (c Check) checkPackage(pkg string) ([]string, error) {
 	if strings.HasSuffix(pkg, "...") {
 		return c.checkDir(pkg)
 	}
 	files, err := checkers.GoFiles(pkg)
 	if err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 	return c.runVet(files)
 }
 
-func (c Check) runVet(paths []string) []string {
+func (c Check) runVet(paths []string) ([]string, error) {
 	if len(paths) == 0 {
-		return nil
+		return nil, nil
 	}
-	args := append([]string{"tool", "vet"}, append(c.Args, paths...)...)
-	res, err := checkers.Exec(exec.Command("go", args...))
+	args := append([]string{"tool", "vet"}, append(c.Flags(), paths...)...)
+	res, err := checkers.Exec(checkers.Cmd(c.Command, "go", args...))
 	if err == nil {
-		return nil
+		return nil, nil
+	}
+	if res.Code == -1 {
+		return nil, checkers.Operational(err)
+	}
+	found, err := checkers.CombineOutput(res)
+	if err != nil {
+		return nil, err
 	}
-	switch res.Code {
-	case 1:
-		return strings.Split(strings.TrimSpace(res.Stderr), "\n")
-	default:
-		return []string{err.Error()}
+	// vet prefixes errors from loading and type checking a package, rather than
+	// from an analyzer, with "vet: ".
+	for _, line := range found {
+		if strings.HasPrefix(line, "vet: ") {
+			return nil, checkers.Operational(errors.New(strings.Join(found, "\n")))
+		}
 	}
+	return found, nil
 }
 
-func (c Check) checkDir(pkg string) []string {
+func (c Check) checkDir(pkg string) ([]string, error) {
 	p, err := checkers.Load(pkg)
 	if err != nil {
-		return []string{err.Error()}
+		return nil, checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
 	}
 	// Loop through each package since we'd like to ignore directories which have
 	// an _ prefix. In the future this allows us to skip vendor directories as well.
 	var errs []string
 	for _, pkg := range p.Pkgs {
-		errs = append(errs, c.checkPackage(pkg)...)
+		found, err := c.checkPackage(pkg)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, found...)
 	}
-	return errs
+	return errs, nil
 }
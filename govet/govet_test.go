@@ -2,13 +2,17 @@ package govet_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"strings"
 
 	"path/filepath"
+	"reflect"
 
 	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint"
 	"github.com/surullabs/lint/govet"
 	"github.com/surullabs/lint/testutil"
 )
@@ -153,6 +157,100 @@ func TestFunc() (err error) {
 `),
 			Validate: testutil.MatchesRegexp(`declaration of "?err"? shadows declaration at`),
 		},
+		{
+			Checker: govet.Check{Enable: []string{"shadow"}},
+			Content: []byte(`package gofmttest
+
+import (
+	"fmt"
+)
+
+func TestFunc() (err error) {
+    err = fmt.Println("another")
+    if err != nil {
+    	err := fmt.Errorf("some error: %v", err)
+    }
+    return err
+}
+`),
+			Validate: testutil.MatchesRegexp(`declaration of "?err"? shadows declaration at`),
+		},
+	})
+
+}
+
+// flags adapts Check.Flags to testutil.Arger, since Check has an Args field.
+type flags func() []string
+
+func (f flags) Args() []string { return f() }
+
+func TestFlags(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: flags(govet.Check{}.Flags), Expected: []string{}},
+		{A: flags(govet.Shadow.Flags), Expected: []string{"--all", "--shadow"}},
+		{A: flags(govet.Check{Enable: []string{"shadow"}}.Flags), Expected: []string{"-shadow"}},
+		{
+			A:        flags(govet.Check{Args: []string{"-v"}, Enable: []string{"shadow"}, Disable: []string{"printf", "unreachable"}}.Flags),
+			Expected: []string{"-v", "-shadow", "-printf=false", "-unreachable=false"},
+		},
 	})
+}
+
+func TestCompileError(t *testing.T) {
+	bin, err := ioutil.TempDir("", "govet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(bin)
+	// A stand in for go tool vet, which prints $OUTPUT to stderr and exits with 1.
+	script := "#!/bin/sh\nprintf \"$OUTPUT\" >&2\nexit 1\n"
+	if err = ioutil.WriteFile(filepath.Join(bin, "go"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", bin+string(os.PathListSeparator)+path)
+
+	tests := []struct {
+		output   string
+		findings []string
+		ops      []string
+	}{
+		{
+			output: "# govet\\nvet: govet.go:3:9: undefined: x\\n",
+			ops:    []string{"govet.Check: exit status 1: vet: govet.go:3:9: undefined: x"},
+		},
+		{
+			output: "govet.go:3: unreachable code\\nvet: govet.go:3:9: undefined: x\\n",
+			ops:    []string{"govet.Check: govet.go:3: unreachable code\nvet: govet.go:3:9: undefined: x"},
+		},
+		{
+			output: "invalid flag -nosuchflag\\n",
+			ops:    []string{"govet.Check: exit status 1: invalid flag -nosuchflag"},
+		},
+		{
+			output:   "govet.go:3: unreachable code\\n",
+			findings: []string{"govet.Check: govet.go:3: unreachable code"},
+		},
+	}
+	for i, test := range tests {
+		check := govet.Check{}
+		check.Env = []string{"OUTPUT=" + test.output}
+		findings, ops := lint.Split(lint.Group{check}.Check("."))
+		if found := errorList(findings); !reflect.DeepEqual(found, test.findings) {
+			t.Errorf("%d: expected findings %v, got %v", i, test.findings, found)
+		}
+		if found := errorList(ops); !reflect.DeepEqual(found, test.ops) {
+			t.Errorf("%d: expected operational errors %v, got %v", i, test.ops, found)
+		}
+	}
+}
 
+func errorList(err error) []string {
+	if err == nil {
+		return nil
+	}
+	return err.(interface {
+		Errors() []string
+	}).Errors()
 }
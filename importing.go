@@ -0,0 +1,52 @@
+package lint
+
+import (
+	"fmt"
+	"go/build"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type importingOnly struct {
+	wrapped
+	importPath string
+}
+
+// ImportingOnly returns a Checker that runs c only for those packages passed to
+// Check which directly import importPath. Wildcard packages, such as ./..., are
+// expanded and the packages importing importPath are passed to c individually.
+// Imports of test files are not considered.
+//
+//    lint.ImportingOnly("net/http", contextcheck)
+func ImportingOnly(importPath string, c Checker) Checker {
+	return importingOnly{importPath: importPath, wrapped: wrapped{c}}
+}
+
+func (i importingOnly) Check(pkgs ...string) error {
+	var importers []string
+	for _, pkg := range pkgs {
+		p, err := checkers.Load(pkg)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+		}
+		for _, sub := range p.Pkgs {
+			b, err := build.Import(sub, "", 0)
+			if err != nil {
+				if _, noGo := err.(*build.NoGoError); noGo {
+					continue
+				}
+				return checkers.Operational(fmt.Errorf("failed to read imports: %s: %v", sub, err))
+			}
+			for _, imp := range b.Imports {
+				if imp == i.importPath {
+					importers = append(importers, sub)
+					break
+				}
+			}
+		}
+	}
+	if len(importers) == 0 {
+		return nil
+	}
+	return i.checker.Check(importers...)
+}
@@ -0,0 +1,44 @@
+package lint_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint"
+)
+
+func TestImportingOnly(t *testing.T) {
+	tmp, err := fakegopath.NewTemporaryWithFiles("importing", []fakegopath.SourceFile{
+		{
+			Content: []byte("package server\n\nimport \"net/http\"\n\nvar _ = http.StatusOK\n"),
+			Dest:    filepath.Join("importing", "server", "server.go"),
+		},
+		{
+			Content: []byte("package model\n\nimport \"strings\"\n\nvar _ = strings.ToUpper\n"),
+			Dest:    filepath.Join("importing", "model", "model.go"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+
+	var checked [][]string
+	record := checkFn(func(pkgs ...string) error {
+		checked = append(checked, pkgs)
+		return nil
+	})
+	err = lint.ImportingOnly("net/http", record).Check("importing/...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, reflect.DeepEqual(checked, [][]string{{"importing/server"}}), fmt.Sprintf("%v", checked))
+
+	checked = nil
+	err = lint.ImportingOnly("net/http", record).Check("importing/model")
+	assert(t, err == nil && checked == nil, fmt.Sprintf("%v %v", err, checked))
+
+	err = lint.Group{lint.ImportingOnly("strings", twoErrors)}.Check("importing/...")
+	assert(t, reflect.DeepEqual(errorList(err), []string{"lint_test.checkFn: err1", "lint_test.checkFn: err2"}), fmt.Sprintf("%v", err))
+}
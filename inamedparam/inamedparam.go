@@ -0,0 +1,26 @@
+// Package inamedparam provides lint integration for the inamedparam linter
+package inamedparam
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the inamedparam linter (https://github.com/macabu/inamedparam)
+type Check struct {
+	// Command sets the environment and working directory used to run inamedparam
+	checkers.Command
+	// SkipSingleParam ignores interface methods with a single unnamed parameter
+	SkipSingleParam bool
+}
+
+// Check runs inamedparam and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "inamedparam", "", "github.com/macabu/inamedparam/cmd/inamedparam", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for inamedparam
+func (c Check) Args() []string {
+	var args []string
+	if c.SkipSingleParam {
+		args = append(args, "-skip-single-param")
+	}
+	return args
+}
@@ -0,0 +1,71 @@
+package inamedparam_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/inamedparam"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestInamedparam(t *testing.T) {
+	testutil.Test(t, "inamedparamtest", []testutil.StaticCheckTest{
+		{
+			Checker: inamedparam.Check{},
+			Content: []byte(`package inamedparamtest
+
+import "context"
+
+// Doer is a test interface
+type Doer interface {
+	Do(ctx context.Context, name string) error
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: inamedparam.Check{},
+			Content: []byte(`package inamedparamtest
+
+import "context"
+
+// Doer is a test interface
+type Doer interface {
+	Do(context.Context, string) error
+}
+`),
+			Validate: testutil.Contains("interface method Do must have all named params"),
+		},
+		{
+			Checker: inamedparam.Check{},
+			Content: []byte(`package inamedparamtest
+
+import "context"
+
+// Doer is a test interface
+type Doer interface {
+	Do(context.Context, string) error
+}
+`),
+			Validate: testutil.SkippedErrors(`must have all named params`),
+		},
+		{
+			Checker: inamedparam.Check{SkipSingleParam: true},
+			Content: []byte(`package inamedparamtest
+
+// Doer is a test interface
+type Doer interface {
+	Do(string) error
+}
+`),
+			Validate: testutil.NoError,
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: inamedparam.Check{}, Expected: nil},
+		{A: inamedparam.Check{SkipSingleParam: true}, Expected: []string{"-skip-single-param"}},
+	})
+}
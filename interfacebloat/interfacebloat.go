@@ -0,0 +1,30 @@
+// Package interfacebloat provides lint integration for the interfacebloat linter
+package interfacebloat
+
+import (
+	"strconv"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the interfacebloat linter (https://github.com/sashamelentyev/interfacebloat)
+type Check struct {
+	// Command sets the environment and working directory used to run interfacebloat
+	checkers.Command
+	// Limit is the maximum number of methods an interface may have. It defaults to 10.
+	Limit int
+}
+
+// Check runs interfacebloat and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "interfacebloat", "", "github.com/sashamelentyev/interfacebloat/cmd/interfacebloat", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for interfacebloat
+func (c Check) Args() []string {
+	limit := c.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	return []string{"-max", strconv.Itoa(limit)}
+}
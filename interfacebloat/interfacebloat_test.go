@@ -0,0 +1,67 @@
+package interfacebloat_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/interfacebloat"
+	"github.com/surullabs/lint/testutil"
+)
+
+const bloated = `package interfacebloattest
+
+// Bloated is a test interface
+type Bloated interface {
+	M1()
+	M2()
+	M3()
+	M4()
+	M5()
+	M6()
+	M7()
+	M8()
+	M9()
+	M10()
+	M11()
+}
+`
+
+func TestInterfacebloat(t *testing.T) {
+	testutil.Test(t, "interfacebloattest", []testutil.StaticCheckTest{
+		{
+			Checker: interfacebloat.Check{},
+			Content: []byte(`package interfacebloattest
+
+// Small is a test interface
+type Small interface {
+	M1()
+	M2()
+	M3()
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  interfacebloat.Check{},
+			Content:  []byte(bloated),
+			Validate: testutil.Contains("the interface has more than 10 methods: 11"),
+		},
+		{
+			Checker:  interfacebloat.Check{Limit: 11},
+			Content:  []byte(bloated),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  interfacebloat.Check{},
+			Content:  []byte(bloated),
+			Validate: testutil.SkippedErrors(`the interface has more than`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: interfacebloat.Check{}, Expected: []string{"-max", "10"}},
+		{A: interfacebloat.Check{Limit: 5}, Expected: []string{"-max", "5"}},
+	})
+}
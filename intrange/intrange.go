@@ -0,0 +1,20 @@
+// Package intrange provides lint integration for the intrange linter
+package intrange
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the intrange linter (https://github.com/ckaznocha/intrange)
+type Check struct {
+	// Command sets the environment and working directory used to run intrange
+	checkers.Command
+}
+
+// Check runs intrange and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "intrange", "", "github.com/ckaznocha/intrange/cmd/intrange", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for intrange
+func (c Check) Args() []string {
+	return nil
+}
@@ -0,0 +1,59 @@
+package intrange_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/intrange"
+	"github.com/surullabs/lint/testutil"
+)
+
+const counted = `package intrangetest
+
+import "fmt"
+
+// Count is a test function
+func Count(n int) {
+	for i := 0; i < n; i++ {
+		fmt.Println(i)
+	}
+}
+`
+
+func TestIntrange(t *testing.T) {
+	testutil.Test(t, "intrangetest", []testutil.StaticCheckTest{
+		{
+			Checker: intrange.Check{},
+			Content: []byte(`package intrangetest
+
+import "fmt"
+
+// Count is a test function
+func Count(n int) {
+	for i := 0; i < n; i++ {
+		if i%2 == 0 {
+			i++
+		}
+		fmt.Println(i)
+	}
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  intrange.Check{},
+			Content:  []byte(counted),
+			Validate: testutil.Contains("for loop can be changed to use an integer range (Go 1.22+)"),
+		},
+		{
+			Checker:  intrange.Check{},
+			Content:  []byte(counted),
+			Validate: testutil.SkippedErrors(`can be changed to use an integer range`),
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: intrange.Check{}, Expected: nil},
+	})
+}
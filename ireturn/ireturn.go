@@ -0,0 +1,36 @@
+// Package ireturn provides lint integration for the ireturn linter
+package ireturn
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the ireturn linter (https://github.com/butuzov/ireturn)
+type Check struct {
+	// Command sets the environment and working directory used to run ireturn
+	checkers.Command
+	// Allow lists interfaces which may be returned, either by name or by one of the
+	// keywords anon, error, empty, generic and stdlib. It cannot be used with Reject.
+	Allow []string
+	// Reject lists interfaces which must not be returned, in the same form as Allow.
+	Reject []string
+}
+
+// Check runs ireturn and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "ireturn", "", "github.com/butuzov/ireturn/cmd/ireturn", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for ireturn
+func (c Check) Args() []string {
+	var args []string
+	if len(c.Allow) > 0 {
+		args = append(args, "-allow", strings.Join(c.Allow, ","))
+	}
+	if len(c.Reject) > 0 {
+		args = append(args, "-reject", strings.Join(c.Reject, ","))
+	}
+	return args
+}
@@ -0,0 +1,87 @@
+package ireturn_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/ireturn"
+	"github.com/surullabs/lint/testutil"
+)
+
+// shape declares an interface and a type implementing it.
+const shape = `package ireturntest
+
+// Shape is a test interface
+type Shape interface {
+	Area() float64
+}
+
+// Square is a test type
+type Square struct {
+	Side float64
+}
+
+// Area is a test method
+func (s Square) Area() float64 { return s.Side * s.Side }
+`
+
+func TestIreturn(t *testing.T) {
+	testutil.Test(t, "ireturntest", []testutil.StaticCheckTest{
+		{
+			Checker: ireturn.Check{},
+			Content: []byte(`package ireturntest
+
+// New is a test function
+func New() Square {
+	return Square{Side: 1}
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: ireturn.Check{},
+			Content: []byte(`package ireturntest
+
+// New is a test function
+func New() Shape {
+	return Square{Side: 1}
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.Contains("New returns interface (ireturntest.Shape)"),
+		},
+		{
+			Checker: ireturn.Check{},
+			Content: []byte(`package ireturntest
+
+// New is a test function
+func New() Shape {
+	return Square{Side: 1}
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.SkippedErrors(`returns interface`),
+		},
+		{
+			Checker: ireturn.Check{Allow: []string{"Shape"}},
+			Content: []byte(`package ireturntest
+
+// New is a test function
+func New() Shape {
+	return Square{Side: 1}
+}
+`),
+			Files:    map[string][]byte{"shape.go": []byte(shape)},
+			Validate: testutil.NoError,
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: ireturn.Check{}, Expected: nil},
+		{A: ireturn.Check{Allow: []string{"error", "stdlib"}}, Expected: []string{"-allow", "error,stdlib"}},
+		{A: ireturn.Check{Reject: []string{"anon"}}, Expected: []string{"-reject", "anon"}},
+	})
+}
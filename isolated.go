@@ -0,0 +1,161 @@
+package lint
+
+import (
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint/checkers"
+)
+
+// isolatedPkg is the name of the temporary GOPATH used by Isolated.
+const isolatedPkg = "lintisolated"
+
+type isolated struct {
+	wrapped
+}
+
+// Isolated returns a Checker that copies the packages passed to Check, along with
+// all the packages they import outside the standard library, to a temporary GOPATH
+// and runs c there. Packages are passed to c using their import paths. Paths of
+// copied files in the returned findings are replaced by their original paths.
+// Only files directly in each package directory are copied.
+//
+// Isolated adds the temporary GOPATH to GOPATH while it runs, as done by CheckBytes,
+// so the same restrictions on concurrent use apply.
+func Isolated(c Checker) Checker {
+	return isolated{wrapped: wrapped{c}}
+}
+
+func (i isolated) Check(pkgs ...string) error {
+	gopathMu.Lock()
+	defer gopathMu.Unlock()
+	var targets []string
+	for _, pkg := range pkgs {
+		p, err := checkers.Load(pkg)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+		}
+		targets = append(targets, p.Pkgs...)
+	}
+	dirs, err := isolatedDirs(targets)
+	if err != nil {
+		return checkers.Operational(err)
+	}
+	var files []fakegopath.SourceFile
+	for path, dir := range dirs {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to list dir %s: %v", dir, err))
+		}
+		for _, entry := range entries {
+			if entry.Mode().IsRegular() {
+				files = append(files, fakegopath.SourceFile{
+					Src:  filepath.Join(dir, entry.Name()),
+					Dest: filepath.Join(path, entry.Name()),
+				})
+			}
+		}
+	}
+	tmp, err := fakegopath.NewTemporaryWithFiles(isolatedPkg, files)
+	if tmp != nil {
+		defer tmp.Reset()
+	}
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to create temporary go path: %v", err))
+	}
+	unload := func() {
+		for _, t := range targets {
+			checkers.Unload(t)
+		}
+	}
+	unload()
+	defer unload()
+	replacer, err := isolatedReplacer(dirs)
+	if err != nil {
+		return checkers.Operational(err)
+	}
+	return mapFindings(i.checker.Check(targets...), replacer.Replace)
+}
+
+// isolatedDirs returns the directories of pkgs and all the packages they import,
+// other than those in the standard library, keyed by import path. Test imports are
+// only followed for pkgs.
+func isolatedDirs(pkgs []string) (map[string]string, error) {
+	dirs := map[string]string{}
+	for _, pkg := range pkgs {
+		b, err := build.Import(pkg, "", 0)
+		if err != nil {
+			if _, noGo := err.(*build.NoGoError); !noGo {
+				return nil, fmt.Errorf("failed to read imports: %s: %v", pkg, err)
+			}
+		}
+		dirs[pkg] = b.Dir
+		imports := append(append(append([]string{}, b.Imports...), b.TestImports...), b.XTestImports...)
+		if err = addDeps(dirs, b.Dir, imports); err != nil {
+			return nil, err
+		}
+	}
+	return dirs, nil
+}
+
+// addDeps adds the directories of imports, as imported from srcDir, and of the
+// packages they import to dirs.
+func addDeps(dirs map[string]string, srcDir string, imports []string) error {
+	for _, imp := range imports {
+		if imp == "C" {
+			continue
+		}
+		dep, err := build.Import(imp, srcDir, build.FindOnly)
+		if err != nil || dep.Goroot {
+			continue
+		}
+		if _, ok := dirs[dep.ImportPath]; ok {
+			continue
+		}
+		dirs[dep.ImportPath] = dep.Dir
+		if dep, err = build.ImportDir(dep.Dir, 0); err != nil {
+			if _, noGo := err.(*build.NoGoError); !noGo {
+				return fmt.Errorf("failed to read imports: %s: %v", imp, err)
+			}
+		}
+		if err = addDeps(dirs, dep.Dir, dep.Imports); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isolatedReplacer returns a Replacer which replaces the paths of the copies of
+// dirs in the temporary GOPATH with the original paths.
+func isolatedReplacer(dirs map[string]string) (*strings.Replacer, error) {
+	sep := string(filepath.Separator)
+	var copies []string
+	originals := map[string]string{}
+	for path, dir := range dirs {
+		b, err := build.Import(path, "", build.FindOnly)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find copied package %s: %v", path, err)
+		}
+		paths := []string{b.Dir}
+		if resolved, err := filepath.EvalSymlinks(b.Dir); err == nil && resolved != b.Dir {
+			paths = append(paths, resolved)
+		}
+		for _, p := range paths {
+			copies = append(copies, p+sep)
+			originals[p+sep] = dir + sep
+		}
+	}
+	// Longer paths are replaced first, so that the copies of nested packages are
+	// not mapped using the directory of their parent.
+	sort.Sort(sort.Reverse(sort.StringSlice(copies)))
+	var pairs []string
+	for _, c := range copies {
+		pairs = append(pairs, c, originals[c])
+	}
+	return strings.NewReplacer(pairs...), nil
+}
@@ -0,0 +1,74 @@
+package lint_test
+
+import (
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+func TestIsolated(t *testing.T) {
+	tmp, err := fakegopath.NewTemporaryWithFiles("isolated", []fakegopath.SourceFile{
+		{
+			Content: []byte("package app\n\nimport \"isolated/dep\"\n\nvar _ = dep.Name\n"),
+			Dest:    filepath.Join("isolated", "app", "app.go"),
+		},
+		{
+			Content: []byte("package dep\n\nimport \"isolated/base\"\n\n// Name is a test variable\nvar Name = base.Name\n"),
+			Dest:    filepath.Join("isolated", "dep", "dep.go"),
+		},
+		{
+			Content: []byte("package base\n\n// Name is a test variable\nvar Name = \"base\"\n"),
+			Dest:    filepath.Join("isolated", "base", "base.go"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+	b, err := build.Import("isolated", "", build.FindOnly)
+	if err != nil {
+		t.Fatalf("failed to find temporary package: %v", err)
+	}
+	app := filepath.Join(b.Dir, "app", "app.go")
+	dep := filepath.Join(b.Dir, "dep", "dep.go")
+
+	var checked []string
+	var copies []string
+	record := checkFn(func(pkgs ...string) error {
+		checked = append(checked, pkgs...)
+		files, err := checkers.GoFiles(pkgs...)
+		if err != nil {
+			return err
+		}
+		copies = append(copies, files...)
+		var errs []string
+		for _, f := range files {
+			// Packages imported indirectly are copied too.
+			data, err := ioutil.ReadFile(filepath.Join(filepath.Dir(f), "..", "base", "base.go"))
+			if err != nil {
+				return err
+			}
+			errs = append(errs, fmt.Sprintf("%s:1:1: copied with %d byte base", f, len(data)))
+		}
+		return checkers.Error(errs...)
+	})
+	err = lint.Isolated(record).Check("isolated/app")
+	assert(t, reflect.DeepEqual(checked, []string{"isolated/app"}), fmt.Sprintf("%v", checked))
+	assert(t, len(copies) == 1 && copies[0] != app && !strings.HasPrefix(copies[0], b.Dir), fmt.Sprintf("%v", copies))
+	assert(t, reflect.DeepEqual(errorList(err), []string{app + ":1:1: copied with 59 byte base"}), fmt.Sprintf("%q", errorList(err)))
+
+	// The original package is used once the check completes.
+	files, err := checkers.GoFiles("isolated/app")
+	assert(t, err == nil && reflect.DeepEqual(files, []string{app}), fmt.Sprintf("%v %v", files, err))
+
+	err = lint.Isolated(lint.Stub(dep + ":4:5: unchanged")).Check("isolated/app")
+	assert(t, reflect.DeepEqual(errorList(err), []string{dep + ":4:5: unchanged"}), fmt.Sprintf("%q", errorList(err)))
+}
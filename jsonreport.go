@@ -0,0 +1,23 @@
+package lint
+
+import "encoding/json"
+
+type jsonResult struct {
+	Checker  string   `json:"checker"`
+	Category string   `json:"category"`
+	Findings []string `json:"findings"`
+}
+
+// FormatJSON formats report as a JSON array holding an object for each checker in
+// report, with the fields checker, category and findings.
+func FormatJSON(report *Report) ([]byte, error) {
+	results := []jsonResult{}
+	for _, r := range report.Results {
+		res := jsonResult{Checker: r.Checker, Category: r.Category, Findings: r.Findings}
+		if res.Findings == nil {
+			res.Findings = []string{}
+		}
+		results = append(results, res)
+	}
+	return json.MarshalIndent(results, "", "  ")
+}
@@ -0,0 +1,76 @@
+package lint
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",chardata"`
+}
+
+// FormatJUnit formats report as JUnit XML. Each checker is reported as a test suite
+// containing a test case for each file in report. Files with findings are reported as
+// failures containing the findings. Findings without file information are reported
+// as a failing test case named after the checker.
+func FormatJUnit(report *Report) ([]byte, error) {
+	var suites junitSuites
+	for _, r := range report.Results {
+		suite := junitSuite{Name: r.Checker}
+		grouped, files, unattributed := byFile(report.Files, r.Findings)
+		if len(unattributed) > 0 {
+			suite.Cases = append(suite.Cases, junitCase{
+				Name:      r.Checker,
+				ClassName: r.Checker,
+				Failure:   newJUnitFailure(unattributed),
+			})
+		}
+		for _, f := range files {
+			c := junitCase{Name: f, ClassName: r.Checker}
+			if errs := grouped[f]; len(errs) > 0 {
+				c.Failure = newJUnitFailure(errs)
+			}
+			suite.Cases = append(suite.Cases, c)
+		}
+		for _, c := range suite.Cases {
+			if c.Failure != nil {
+				suite.Failures++
+			}
+		}
+		suite.Tests = len(suite.Cases)
+		suites.Suites = append(suites.Suites, suite)
+	}
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to format junit xml: %v", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func newJUnitFailure(findings []string) *junitFailure {
+	msg := "1 finding"
+	if len(findings) != 1 {
+		msg = fmt.Sprintf("%d findings", len(findings))
+	}
+	return &junitFailure{Message: msg, Contents: strings.Join(findings, "\n")}
+}
@@ -0,0 +1,74 @@
+package lint_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestFormatJUnit(t *testing.T) {
+	report := &lint.Report{
+		Files: []string{"pkg/bad.go", "pkg/good.go"},
+		Results: []lint.Result{
+			{Checker: "errcheck.Check", Findings: []string{
+				"pkg/bad.go:3:2: f.Close()",
+				"pkg/bad.go:7:2: <w.Write()>",
+			}},
+			{Checker: "golint.Check"},
+		},
+	}
+	data, err := lint.FormatJUnit(report)
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, strings.HasPrefix(string(data), xml.Header), string(data))
+
+	var parsed struct {
+		Suites []struct {
+			Name     string `xml:"name,attr"`
+			Tests    int    `xml:"tests,attr"`
+			Failures int    `xml:"failures,attr"`
+			Cases    []struct {
+				Name    string `xml:"name,attr"`
+				Failure *struct {
+					Message  string `xml:"message,attr"`
+					Contents string `xml:",chardata"`
+				} `xml:"failure"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	err = xml.Unmarshal(data, &parsed)
+	assert(t, err == nil, fmt.Sprintf("invalid xml: %v\n%s", err, data))
+	assert(t, len(parsed.Suites) == 2, string(data))
+
+	errcheck := parsed.Suites[0]
+	assert(t, errcheck.Name == "errcheck.Check" && errcheck.Tests == 2 && errcheck.Failures == 1, string(data))
+	assert(t, errcheck.Cases[0].Name == "pkg/bad.go" && errcheck.Cases[0].Failure != nil, string(data))
+	assert(t, errcheck.Cases[0].Failure.Message == "2 findings", string(data))
+	assert(t, errcheck.Cases[0].Failure.Contents == "pkg/bad.go:3:2: f.Close()\npkg/bad.go:7:2: <w.Write()>", string(data))
+	assert(t, errcheck.Cases[1].Name == "pkg/good.go" && errcheck.Cases[1].Failure == nil, string(data))
+
+	golint := parsed.Suites[1]
+	assert(t, golint.Name == "golint.Check" && golint.Tests == 2 && golint.Failures == 0, string(data))
+}
+
+func TestFormatJUnitUnattributed(t *testing.T) {
+	data, err := lint.FormatJUnit(&lint.Report{
+		Files:   []string{"a.go"},
+		Results: []lint.Result{{Checker: "gofmt.Check", Findings: []string{"exit status 2"}}},
+	})
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, strings.Contains(string(data), `<testcase name="gofmt.Check" classname="gofmt.Check">`), string(data))
+	assert(t, strings.Contains(string(data), `<failure message="1 finding">exit status 2</failure>`), string(data))
+}
+
+func TestGroupReport(t *testing.T) {
+	report, err := lint.Group{twoErrors, expectRecursive}.Report("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, len(report.Files) > 0, "expected files to be listed")
+	assert(t, len(report.Results) == 2, fmt.Sprintf("%v", report.Results))
+	assert(t, report.Results[0].Checker == "lint_test.checkFn", report.Results[0].Checker)
+	assert(t, strings.Join(report.Results[0].Findings, ",") == "err1,err2", fmt.Sprintf("%v", report.Results[0]))
+	assert(t, report.Results[1].Findings == nil, fmt.Sprintf("%v", report.Results[1]))
+}
@@ -7,8 +7,8 @@ package lint
 
 import (
 	"reflect"
+	"strings"
 
-	"github.com/surullabs/lint/checkers"
 	"github.com/surullabs/lint/errcheck"
 	"github.com/surullabs/lint/gofmt"
 	"github.com/surullabs/lint/golint"
@@ -17,7 +17,8 @@ import (
 	"github.com/surullabs/lint/govet"
 )
 
-// Default holds a default list of lint tools for convenient use. These include
+// Default holds a default list of lint tools for convenient use. Each implements
+// Named. These include
 //
 //     - gofmt -d
 //     - go tool vet -shadow
@@ -34,6 +35,64 @@ var Default = Group{
 	errcheck.Check{},
 }
 
+// DefaultOptions configures the Group returned by DefaultWith.
+type DefaultOptions struct {
+	// Replace holds checkers used instead of the member of Default with the same
+	// name, such as errcheck.Check{Blank: true} in place of errcheck.Check{}.
+	// Checkers which do not replace a member are appended.
+	Replace []Checker
+	// Without holds the names of members of Default to leave out, either as used by
+	// Group, such as golint.Check, or by package, such as golint.
+	Without []string
+}
+
+// DefaultWith returns a copy of Default configured using opts. Members of Default
+// keep their order, followed by any checkers in opts.Replace which do not replace
+// a member.
+//
+//    lint.DefaultWith(lint.DefaultOptions{
+//    	Replace: []lint.Checker{errcheck.Check{Blank: true}},
+//    	Without: []string{"gosimple"},
+//    })
+func DefaultWith(opts DefaultOptions) Group {
+	replacements := map[string]Checker{}
+	for _, c := range opts.Replace {
+		replacements[checkerName(c)] = c
+	}
+	var g Group
+	for _, c := range Default {
+		name := checkerName(c)
+		if r, ok := replacements[name]; ok {
+			c = r
+			delete(replacements, name)
+		}
+		if !hasName(opts.Without, c) {
+			g = append(g, c)
+		}
+	}
+	for _, c := range opts.Replace {
+		if _, ok := replacements[checkerName(c)]; ok {
+			g = append(g, c)
+		}
+	}
+	return g
+}
+
+// hasName returns true if names holds the name of c or the package it belongs to.
+func hasName(names []string, c Checker) bool {
+	name := strings.TrimPrefix(checkerName(c), "*")
+	pkg := name
+	if i := strings.Index(name, "."); i >= 0 {
+		pkg = name[:i]
+	}
+	for _, n := range names {
+		if n == name || n == pkg {
+			return true
+		}
+	}
+	return false
+}
+
 type errors interface {
 	Errors() []string
 }
@@ -47,6 +106,23 @@ type Checker interface {
 	Check(pkgs ...string) error
 }
 
+// Named is the interface that wraps the Name method.
+//
+// Name returns the name used to identify a Checker, such as when prefixing its
+// errors in a Group. Checkers that do not implement Named are identified by
+// their type.
+type Named interface {
+	Name() string
+}
+
+// Fixer is the interface that wraps the Fix method.
+//
+// Fix rewrites files in pkg to fix any issues found and returns the list of
+// files that were modified. Files which need no changes are left untouched.
+type Fixer interface {
+	Fix(pkg string) ([]string, error)
+}
+
 // Group is a Checker list that is applied in sequence. See Check for details on
 // how it is applied.
 type Group []Checker
@@ -55,7 +131,7 @@ type Group []Checker
 //
 // The error returned is either nil or contains errors returned by each Checker.
 // These are exposed using the errors interface described in Skip and prefixed with the type of the
-// Checker that generated the error. For example, the following error generated by govet.Checker:
+// Checker that generated the error, or its name if it implements Named. For example, the following error generated by govet.Checker:
 //
 //    file.go:23: err is unintentionally shadowed.
 //
@@ -66,28 +142,41 @@ type Group []Checker
 // A checker is not shorted-circuited by a previous checker returning an error.
 //
 // Any error that implements errors is flattened into the final error list.
+// If any Checker returns a checkers.OperationalError, it is recorded so that it
+// can be separated from other errors using Split. This includes operational errors
+// recorded by a nested Group, or by checkers wrapping other checkers.
 func (g Group) Check(pkgs ...string) error {
-	var errs []string
+	var res groupErrors
 	for _, checker := range g {
-		name := reflect.TypeOf(checker).String()
-		switch err := checker.Check(pkgs...).(type) {
-		case nil:
-			continue
-		case errors:
-			cerrs := err.Errors()
-			for _, e := range cerrs {
-				errs = append(errs, name+": "+e)
-			}
-		default:
-			errs = append(errs, name+": "+err.Error())
-		}
+		res.add(checkerName(checker)+": ", checker.Check(pkgs...))
 	}
-	if len(errs) == 0 {
-		return nil
+	return res.err()
+}
+
+// checkerName returns the name used to prefix errors generated by c.
+func checkerName(c Checker) string {
+	if n, ok := c.(Named); ok {
+		return n.Name()
 	}
-	return checkers.Error(errs...)
+	return reflect.TypeOf(c).String()
+}
+
+// wrapped is embedded by checkers wrapping another checker. It forwards Name,
+// Category and Weight to the wrapped checker, so that wrapping a checker does not
+// change how it is identified in a Group, categorized or scheduled by Concurrent.
+type wrapped struct {
+	checker Checker
 }
 
+// Name returns the name of the wrapped checker.
+func (w wrapped) Name() string { return checkerName(w.checker) }
+
+// Category returns the category of the wrapped checker.
+func (w wrapped) Category() string { return CategoryOf(w.checker) }
+
+// Weight returns the weight of the wrapped checker.
+func (w wrapped) Weight() int { return WeightOf(w.checker) }
+
 // With returns a copy of g with checkers appended
 func (g Group) With(checkers ...Checker) Group {
 	copied := make([]Checker, len(g))
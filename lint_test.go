@@ -3,9 +3,12 @@ package lint_test
 import (
 	"testing"
 
+	"github.com/sridharv/fakegopath"
+
 	"log"
 
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"runtime/debug"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/surullabs/lint"
 	"github.com/surullabs/lint/checkers"
 	"github.com/surullabs/lint/dupl"
+	"github.com/surullabs/lint/errcheck"
 	"github.com/surullabs/lint/gofmt"
 	"github.com/surullabs/lint/govet"
 )
@@ -24,7 +28,14 @@ func TestLint(t *testing.T) {
 		// Ignore all errors from unused.go
 		lint.RegexpMatch(`unused\.go`),
 		// Ignore duplicates we're okay with.
-		dupl.SkipTwo, dupl.Skip("golint.go:1,12"))
+		dupl.SkipTwo, dupl.Skip("golint.go:1,12"),
+		// Linters run using checkers.LintCommand share the same Check method.
+		dupl.Skip("canonicalheader.go:13,15"), dupl.Skip("golint.go:12,14"),
+		// Linters without options share the same package layout.
+		dupl.Skip("canonicalheader.go:2,20"), dupl.Skip("inamedparam.go:2,26"),
+		dupl.Skip("inamedparam.go:20,26"),
+		// Linters with the same kinds of options build their arguments alike.
+		dupl.Skip("reassign.go:2,31"), dupl.Skip("exhaustruct.go:2,37"), dupl.Skip("decorder.go:27,36"))
 
 	if err != nil {
 		t.Fatal(err)
@@ -121,6 +132,9 @@ func TestSkip(t *testing.T) {
 		err != nil && err.Error() == "lint_test.checkFn: err2\nlint_test.checkFn: ungrouped: 1",
 		fmt.Sprintf("%v", err))
 
+	// Each finding is filtered as is, including blank findings.
+	err = lint.Skip(checkers.Error("err1", "", "err2"), errorIs("err1"))
+	assert(t, reflect.DeepEqual(errorList(err), []string{"", "err2"}), fmt.Sprintf("%q", errorList(err)))
 }
 
 func TestRegexpMatch(t *testing.T) {
@@ -147,6 +161,52 @@ func TestRegexpMatch(t *testing.T) {
 		fmt.Sprintf("%v", err))
 }
 
+func TestDefaultMembers(t *testing.T) {
+	assert(t, len(lint.Default) > 0, "expected default checkers")
+	var names []string
+	for _, c := range lint.Default {
+		n, ok := c.(lint.Named)
+		assert(t, ok, fmt.Sprintf("%T does not implement Named", c))
+		names = append(names, n.Name())
+	}
+	expected := []string{"gofmt.Check", "govet.Check", "golint.Check", "gosimple.Check", "gostaticcheck.Check", "errcheck.Check"}
+	assert(t, reflect.DeepEqual(names, expected), fmt.Sprintf("%q", names))
+}
+
+func TestDefaultWith(t *testing.T) {
+	names := func(g lint.Group) []string {
+		var all []string
+		for _, c := range g {
+			all = append(all, c.(lint.Named).Name())
+		}
+		return all
+	}
+	assert(t, reflect.DeepEqual(lint.DefaultWith(lint.DefaultOptions{}), lint.Default), "expected the default group")
+
+	g := lint.DefaultWith(lint.DefaultOptions{
+		Replace: []lint.Checker{lint.Stub(), errcheck.Check{Blank: true}},
+		Without: []string{"gosimple", "golint.Check"},
+	})
+	expected := []string{"gofmt.Check", "govet.Check", "gostaticcheck.Check", "errcheck.Check", "lint.Stub"}
+	assert(t, reflect.DeepEqual(names(g), expected), fmt.Sprintf("%q", names(g)))
+	assert(t, reflect.DeepEqual(g[3], errcheck.Check{Blank: true}), fmt.Sprintf("%#v", g[3]))
+	assert(t, len(lint.Default) == 6, "expected Default to be unchanged")
+}
+
+func TestDefaultClean(t *testing.T) {
+	tmp, err := fakegopath.NewTemporaryWithFiles("lintdefault", []fakegopath.SourceFile{{
+		Content: []byte("// Package clean is a test package.\npackage clean\n\n// Answer returns the answer.\nfunc Answer() int { return 42 }\n"),
+		Dest:    filepath.Join("clean", "clean.go"),
+	}})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+	if err = lint.Default.Check("clean"); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func Example() {
 	// Run the default set of linters
 	err := lint.Default.Check("./...")
@@ -0,0 +1,46 @@
+// Package loggercheck provides lint integration for the loggercheck linter
+package loggercheck
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the loggercheck linter (https://github.com/timonwong/loggercheck)
+//
+// All supported loggers are checked if none of Zap, Logr or Slog are set. If any
+// are set, only the selected loggers are checked.
+type Check struct {
+	// Command sets the environment and working directory used to run loggercheck
+	checkers.Command
+	// Zap checks go.uber.org/zap SugaredLogger calls
+	Zap bool
+	// Logr checks github.com/go-logr/logr calls
+	Logr bool
+	// Slog checks log/slog calls
+	Slog bool
+}
+
+// Check runs loggercheck and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "loggercheck", "", "github.com/timonwong/loggercheck/cmd/loggercheck", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for loggercheck
+func (c Check) Args() []string {
+	if !c.Zap && !c.Logr && !c.Slog {
+		return nil
+	}
+	disabled := []string{"kitlog", "klog"}
+	if !c.Logr {
+		disabled = append(disabled, "logr")
+	}
+	if !c.Slog {
+		disabled = append(disabled, "slog")
+	}
+	if !c.Zap {
+		disabled = append(disabled, "zap")
+	}
+	return []string{"-disable", strings.Join(disabled, ",")}
+}
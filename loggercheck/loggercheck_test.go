@@ -0,0 +1,63 @@
+package loggercheck_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/loggercheck"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestLoggercheck(t *testing.T) {
+	testutil.Test(t, "loggerchecktest", []testutil.StaticCheckTest{
+		{
+			Checker: loggercheck.Check{Slog: true},
+			Content: []byte(`package loggerchecktest
+
+import "log/slog"
+
+// TestFunc is a test function
+func TestFunc() {
+	slog.Info("message", "key", "value")
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: loggercheck.Check{Slog: true},
+			Content: []byte(`package loggerchecktest
+
+import "log/slog"
+
+// TestFunc is a test function
+func TestFunc() {
+	slog.Info("message", "key", "value", "dangling")
+}
+`),
+			Validate: testutil.Contains("odd number of arguments passed as key-value pairs for logging"),
+		},
+		{
+			Checker: loggercheck.Check{},
+			Content: []byte(`package loggerchecktest
+
+import "log/slog"
+
+// TestFunc is a test function
+func TestFunc() {
+	slog.Info("message", "key", "value", "dangling")
+}
+`),
+			Validate: testutil.SkippedErrors(`odd number of arguments`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: loggercheck.Check{}, Expected: nil},
+		{A: loggercheck.Check{Zap: true}, Expected: []string{"-disable", "kitlog,klog,logr,slog"}},
+		{A: loggercheck.Check{Logr: true}, Expected: []string{"-disable", "kitlog,klog,slog,zap"}},
+		{A: loggercheck.Check{Slog: true}, Expected: []string{"-disable", "kitlog,klog,logr,zap"}},
+		{A: loggercheck.Check{Zap: true, Logr: true, Slog: true}, Expected: []string{"-disable", "kitlog,klog"}},
+	})
+}
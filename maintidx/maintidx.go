@@ -0,0 +1,49 @@
+// Package maintidx provides lint integration for the maintidx linter
+package maintidx
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the maintidx linter (https://github.com/yagipy/maintidx) to report
+// functions with a low maintainability index.
+type Check struct {
+	// Command sets the environment and working directory used to run maintidx
+	checkers.Command
+	// Under is the maintainability index below which functions are reported. It
+	// defaults to 20 if it is 0.
+	Under int
+}
+
+// indexRE matches the message reported by maintidx for a function, of the form
+//   Function name: <name>, Cyclomatic Complexity: <n>, Halstead Volume: <v>, Maintainability Index: <index>
+var indexRE = regexp.MustCompile(`Function name: (\S+), Cyclomatic Complexity: [0-9]+, Halstead Volume: [0-9.]+, Maintainability Index: ([0-9]+)`)
+
+// Check runs maintidx and returns any errors found. Findings are reported as
+//   <file:line:col>: Function <name> has maintainability index <index>, which is low
+func (c Check) Check(pkgs ...string) error {
+	err := checkers.LintCommand(c.Command, "maintidx", "", "github.com/yagipy/maintidx/cmd/maintidx", pkgs, c.Args()...)
+	found, ok := err.(interface {
+		Errors() []string
+	})
+	if !ok {
+		return err
+	}
+	var errs []string
+	for _, line := range found.Errors() {
+		errs = append(errs, indexRE.ReplaceAllString(line, "Function $1 has maintainability index $2, which is low"))
+	}
+	return checkers.Error(errs...)
+}
+
+// Args returns command line arguments used for maintidx
+func (c Check) Args() []string {
+	under := c.Under
+	if under == 0 {
+		under = 20
+	}
+	return []string{"-under", strconv.Itoa(under)}
+}
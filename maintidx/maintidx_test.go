@@ -0,0 +1,54 @@
+package maintidx_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/surullabs/lint/maintidx"
+	"github.com/surullabs/lint/testutil"
+)
+
+// complexSource returns a package with a single function containing many branches.
+func complexSource() []byte {
+	var b bytes.Buffer
+	b.WriteString("package maintidxtest\n\n// Score is a test function\nfunc Score(x int) int {\n\ty := 0\n")
+	for i := 0; i < 150; i++ {
+		fmt.Fprintf(&b, "\tif x > %d {\n\t\ty += x * %d\n\t} else if x < -%d {\n\t\ty -= %d\n\t}\n", i, i+1, i, i+2)
+	}
+	b.WriteString("\treturn y\n}\n")
+	return b.Bytes()
+}
+
+func TestMaintidx(t *testing.T) {
+	testutil.Test(t, "maintidxtest", []testutil.StaticCheckTest{
+		{
+			Checker: maintidx.Check{},
+			Content: []byte(`package maintidxtest
+
+// Double is a test function
+func Double(x int) int {
+	return 2 * x
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  maintidx.Check{},
+			Content:  complexSource(),
+			Validate: testutil.MatchesRegexp(`file\.go:4:1: Function Score has maintainability index [0-9]+, which is low`),
+		},
+		{
+			Checker:  maintidx.Check{},
+			Content:  complexSource(),
+			Validate: testutil.SkippedErrors(`which is low`),
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: maintidx.Check{}, Expected: []string{"-under", "20"}},
+		{A: maintidx.Check{Under: 40}, Expected: []string{"-under", "40"}},
+	})
+}
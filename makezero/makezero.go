@@ -0,0 +1,26 @@
+// Package makezero provides lint integration for the makezero linter
+package makezero
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the makezero linter (https://github.com/ashanbrown/makezero)
+type Check struct {
+	// Command sets the environment and working directory used to run makezero
+	checkers.Command
+	// Always reports any non-empty slice initialization, even when it is not appended to
+	Always bool
+}
+
+// Check runs makezero and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "makezero", "", "github.com/ashanbrown/makezero", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for makezero
+func (c Check) Args() []string {
+	var args []string
+	if c.Always {
+		args = append(args, "-always")
+	}
+	return args
+}
@@ -0,0 +1,60 @@
+package makezero_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/makezero"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestMakezero(t *testing.T) {
+	testutil.Test(t, "makezerotest", []testutil.StaticCheckTest{
+		{
+			Checker: makezero.Check{},
+			Content: []byte(`package makezerotest
+
+// TestFunc is a test function
+func TestFunc(n int) []int {
+	s := make([]int, 0, n)
+	s = append(s, 1)
+	return s
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: makezero.Check{},
+			Content: []byte(`package makezerotest
+
+// TestFunc is a test function
+func TestFunc(n int) []int {
+	s := make([]int, n)
+	s = append(s, 1)
+	return s
+}
+`),
+			Validate: testutil.Contains("with non-zero initialized length"),
+		},
+		{
+			Checker: makezero.Check{},
+			Content: []byte(`package makezerotest
+
+// TestFunc is a test function
+func TestFunc(n int) []int {
+	s := make([]int, n)
+	s = append(s, 1)
+	return s
+}
+`),
+			Validate: testutil.SkippedErrors(`append to slice .s. with non-zero initialized length`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: makezero.Check{}, Expected: nil},
+		{A: makezero.Check{Always: true}, Expected: []string{"-always"}},
+	})
+}
@@ -0,0 +1,36 @@
+// Package mnd provides lint integration for the mnd linter
+package mnd
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the mnd magic number detector (https://github.com/tommy-muehle/go-mnd)
+type Check struct {
+	// Command sets the environment and working directory used to run mnd
+	checkers.Command
+	// Checks is a list of the checks to run, such as argument, case, condition,
+	// operation, return and assign. mnd runs all checks if it is empty.
+	Checks []string
+	// Ignored is a list of numbers which are never reported, such as 0 or 1
+	Ignored []string
+}
+
+// Check runs mnd and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "mnd", "", "github.com/tommy-muehle/go-mnd/v2/cmd/mnd", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for mnd
+func (c Check) Args() []string {
+	var args []string
+	if len(c.Checks) > 0 {
+		args = append(args, "-checks", strings.Join(c.Checks, ","))
+	}
+	if len(c.Ignored) > 0 {
+		args = append(args, "-ignored-numbers", strings.Join(c.Ignored, ","))
+	}
+	return args
+}
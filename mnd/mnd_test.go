@@ -0,0 +1,89 @@
+package mnd_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/mnd"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestMnd(t *testing.T) {
+	testutil.Test(t, "mndtest", []testutil.StaticCheckTest{
+		{
+			Checker: mnd.Check{},
+			Content: []byte(`package mndtest
+
+import "time"
+
+const defaultDelay = 42
+
+// Wait is a test function
+func Wait() {
+	time.Sleep(defaultDelay)
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: mnd.Check{},
+			Content: []byte(`package mndtest
+
+import "time"
+
+// Wait is a test function
+func Wait() {
+	time.Sleep(42)
+}
+`),
+			Validate: testutil.Contains("Magic number: 42, in <argument> detected"),
+		},
+		{
+			Checker: mnd.Check{},
+			Content: []byte(`package mndtest
+
+import "time"
+
+// Wait is a test function
+func Wait() {
+	time.Sleep(42)
+}
+`),
+			Validate: testutil.SkippedErrors(`Magic number: 42`),
+		},
+		{
+			Checker: mnd.Check{Ignored: []string{"42"}},
+			Content: []byte(`package mndtest
+
+import "time"
+
+// Wait is a test function
+func Wait() {
+	time.Sleep(42)
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: mnd.Check{Checks: []string{"return"}},
+			Content: []byte(`package mndtest
+
+import "time"
+
+// Wait is a test function
+func Wait() {
+	time.Sleep(42)
+}
+`),
+			Validate: testutil.NoError,
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: mnd.Check{}, Expected: nil},
+		{A: mnd.Check{Checks: []string{"argument", "case"}}, Expected: []string{"-checks", "argument,case"}},
+		{A: mnd.Check{Ignored: []string{"0", "1"}}, Expected: []string{"-ignored-numbers", "0,1"}},
+	})
+}
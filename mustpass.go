@@ -0,0 +1,26 @@
+package lint
+
+import "fmt"
+
+// MustPass runs c for pkg and returns nil if no findings are reported. Otherwise
+// the findings are returned prefixed by msg, as in
+//
+//    package <pkg> <msg>:
+//    <findings>
+//
+// This allows invariants to be checked from regular tests with a useful message.
+// Operational errors are returned unchanged.
+//
+//    if err := lint.MustPass(errcheck.Check{}, "./server", "must have no unchecked errors"); err != nil {
+//    	t.Fatal(err)
+//    }
+func MustPass(c Checker, pkg string, msg string) error {
+	err := c.Check(pkg)
+	if err == nil {
+		return nil
+	}
+	if _, ops := Split(err); ops != nil {
+		return err
+	}
+	return fmt.Errorf("package %s %s:\n%v", pkg, msg, err)
+}
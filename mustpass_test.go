@@ -0,0 +1,20 @@
+package lint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestMustPass(t *testing.T) {
+	const msg = "must have no unchecked errors"
+	assert(t, lint.MustPass(lint.Stub(), "./server", msg) == nil, "expected no error")
+
+	err := lint.MustPass(lint.Stub("a.go:1:1: unchecked", "b.go:2:1: unchecked"), "./server", msg)
+	expected := "package ./server must have no unchecked errors:\na.go:1:1: unchecked\nb.go:2:1: unchecked"
+	assert(t, err != nil && err.Error() == expected, fmt.Sprintf("%v", err))
+
+	err = lint.MustPass(lint.StubError(fmt.Errorf("not installed")), "./server", msg)
+	assert(t, err != nil && err.Error() == "not installed", fmt.Sprintf("%v", err))
+}
@@ -0,0 +1,27 @@
+// Package musttag provides lint integration for the musttag linter
+package musttag
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the musttag linter (https://github.com/go-simpler/musttag)
+type Check struct {
+	// Command sets the environment and working directory used to run musttag
+	checkers.Command
+	// Functions lists additional serialization functions to check, in the form
+	// name:tag:argpos, such as github.com/org/codec.Marshal:codec:0
+	Functions []string
+}
+
+// Check runs musttag and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "musttag", "", "go-simpler.org/musttag/cmd/musttag", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for musttag
+func (c Check) Args() []string {
+	var args []string
+	for _, fn := range c.Functions {
+		args = append(args, "-fn", fn)
+	}
+	return args
+}
@@ -0,0 +1,79 @@
+package musttag_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/musttag"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestMusttag(t *testing.T) {
+	testutil.Test(t, "musttagtest", []testutil.StaticCheckTest{
+		{
+			Checker: musttag.Check{},
+			Content: []byte(`package musttagtest
+
+import "encoding/json"
+
+// User is a test type
+type User struct {
+	Name string ` + "`json:\"name\"`" + `
+}
+
+// Encode is a test function
+func Encode(u User) ([]byte, error) {
+	return json.Marshal(u)
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: musttag.Check{},
+			Content: []byte(`package musttagtest
+
+import "encoding/json"
+
+// User is a test type
+type User struct {
+	Name string
+}
+
+// Encode is a test function
+func Encode(u User) ([]byte, error) {
+	return json.Marshal(u)
+}
+`),
+			Validate: testutil.MatchesRegexp(`should be annotated with the .json. tag`),
+		},
+		{
+			Checker: musttag.Check{},
+			Content: []byte(`package musttagtest
+
+import "encoding/json"
+
+// User is a test type
+type User struct {
+	Name string
+}
+
+// Encode is a test function
+func Encode(u User) ([]byte, error) {
+	return json.Marshal(u)
+}
+`),
+			Validate: testutil.SkippedErrors(`should be annotated with the`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: musttag.Check{}, Expected: nil},
+		{A: musttag.Check{Functions: []string{"example.com/codec.Marshal:codec:0"}}, Expected: []string{"-fn", "example.com/codec.Marshal:codec:0"}},
+		{
+			A:        musttag.Check{Functions: []string{"example.com/a.Encode:a:0", "example.com/b.Encode:b:1"}},
+			Expected: []string{"-fn", "example.com/a.Encode:a:0", "-fn", "example.com/b.Encode:b:1"},
+		},
+	})
+}
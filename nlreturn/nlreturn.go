@@ -0,0 +1,32 @@
+// Package nlreturn provides lint integration for the nlreturn linter
+package nlreturn
+
+import (
+	"strconv"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the nlreturn linter (https://github.com/ssgreg/nlreturn)
+type Check struct {
+	// Command sets the environment and working directory used to run nlreturn
+	checkers.Command
+	// BlockSize is the size of a block which may end with a return or branch
+	// statement without a preceding blank line. If zero, the nlreturn default of
+	// 1 is used.
+	BlockSize int
+}
+
+// Check runs nlreturn and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "nlreturn", "", "github.com/ssgreg/nlreturn/v2/cmd/nlreturn", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for nlreturn
+func (c Check) Args() []string {
+	var args []string
+	if c.BlockSize > 0 {
+		args = append(args, "-block-size", strconv.Itoa(c.BlockSize))
+	}
+	return args
+}
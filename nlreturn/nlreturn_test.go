@@ -0,0 +1,63 @@
+package nlreturn_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/nlreturn"
+	"github.com/surullabs/lint/testutil"
+)
+
+const crowded = `package nlreturntest
+
+import "fmt"
+
+// Greet is a test function
+func Greet(name string) string {
+	greeting := "hello " + name
+	fmt.Println(greeting)
+	return greeting
+}
+`
+
+func TestNlreturn(t *testing.T) {
+	testutil.Test(t, "nlreturntest", []testutil.StaticCheckTest{
+		{
+			Checker: nlreturn.Check{},
+			Content: []byte(`package nlreturntest
+
+import "fmt"
+
+// Greet is a test function
+func Greet(name string) string {
+	greeting := "hello " + name
+	fmt.Println(greeting)
+
+	return greeting
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  nlreturn.Check{},
+			Content:  []byte(crowded),
+			Validate: testutil.Contains("return with no blank line before"),
+		},
+		{
+			Checker:  nlreturn.Check{},
+			Content:  []byte(crowded),
+			Validate: testutil.SkippedErrors(`return with no blank line before`),
+		},
+		{
+			Checker:  nlreturn.Check{BlockSize: 3},
+			Content:  []byte(crowded),
+			Validate: testutil.NoError,
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: nlreturn.Check{}, Expected: nil},
+		{A: nlreturn.Check{BlockSize: 2}, Expected: []string{"-block-size", "2"}},
+	})
+}
@@ -0,0 +1,27 @@
+// Package nonamedreturns provides lint integration for the nonamedreturns linter
+package nonamedreturns
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the nonamedreturns linter (https://github.com/firefart/nonamedreturns)
+type Check struct {
+	// Command sets the environment and working directory used to run nonamedreturns
+	checkers.Command
+	// ReportErrorInDefer also reports named error results which are modified in a
+	// deferred function
+	ReportErrorInDefer bool
+}
+
+// Check runs nonamedreturns and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "nonamedreturns", "", "github.com/firefart/nonamedreturns", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for nonamedreturns
+func (c Check) Args() []string {
+	var args []string
+	if c.ReportErrorInDefer {
+		args = append(args, "-report-error-in-defer")
+	}
+	return args
+}
@@ -0,0 +1,50 @@
+package nonamedreturns_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/nonamedreturns"
+	"github.com/surullabs/lint/testutil"
+)
+
+const named = `package nonamedreturnstest
+
+// Double is a test function
+func Double(x int) (result int) {
+	result = 2 * x
+	return
+}
+`
+
+func TestNonamedreturns(t *testing.T) {
+	testutil.Test(t, "nonamedreturnstest", []testutil.StaticCheckTest{
+		{
+			Checker: nonamedreturns.Check{},
+			Content: []byte(`package nonamedreturnstest
+
+// Double is a test function
+func Double(x int) int {
+	return 2 * x
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  nonamedreturns.Check{},
+			Content:  []byte(named),
+			Validate: testutil.Contains(`named return "result" with type "int" found`),
+		},
+		{
+			Checker:  nonamedreturns.Check{},
+			Content:  []byte(named),
+			Validate: testutil.SkippedErrors(`named return "result"`),
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: nonamedreturns.Check{}, Expected: nil},
+		{A: nonamedreturns.Check{ReportErrorInDefer: true}, Expected: []string{"-report-error-in-defer"}},
+	})
+}
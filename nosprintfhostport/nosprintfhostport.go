@@ -0,0 +1,20 @@
+// Package nosprintfhostport provides lint integration for the nosprintfhostport linter
+package nosprintfhostport
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the nosprintfhostport linter (https://github.com/stbenjam/no-sprintf-host-port)
+type Check struct {
+	// Command sets the environment and working directory used to run nosprintfhostport
+	checkers.Command
+}
+
+// Check runs nosprintfhostport and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "nosprintfhostport", "", "github.com/stbenjam/no-sprintf-host-port/cmd/nosprintfhostport", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for nosprintfhostport
+func (c Check) Args() []string {
+	return nil
+}
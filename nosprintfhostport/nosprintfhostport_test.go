@@ -0,0 +1,62 @@
+package nosprintfhostport_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/nosprintfhostport"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestNoSprintfHostPort(t *testing.T) {
+	testutil.Test(t, "nosprintfhostporttest", []testutil.StaticCheckTest{
+		{
+			Checker: nosprintfhostport.Check{},
+			Content: []byte(`package nosprintfhostporttest
+
+import (
+	"net"
+	"strconv"
+)
+
+// URL is a test function
+func URL(host string, port int) string {
+	return "http://" + net.JoinHostPort(host, strconv.Itoa(port)) + "/"
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: nosprintfhostport.Check{},
+			Content: []byte(`package nosprintfhostporttest
+
+import "fmt"
+
+// URL is a test function
+func URL(host string, port int) string {
+	return fmt.Sprintf("http://%s:%d/", host, port)
+}
+`),
+			Validate: testutil.Contains("host:port in url should be constructed with net.JoinHostPort and not directly with fmt.Sprintf"),
+		},
+		{
+			Checker: nosprintfhostport.Check{},
+			Content: []byte(`package nosprintfhostporttest
+
+import "fmt"
+
+// URL is a test function
+func URL(host string, port int) string {
+	return fmt.Sprintf("http://%s:%d/", host, port)
+}
+`),
+			Validate: testutil.SkippedErrors(`should be constructed with net.JoinHostPort`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: nosprintfhostport.Check{}, Expected: nil},
+	})
+}
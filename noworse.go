@@ -0,0 +1,91 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type noWorse struct {
+	wrapped
+	statePath string
+}
+
+// NoWorse returns a Checker that runs c and fails only if c reports more findings
+// than the count recorded for it in the JSON file at statePath. The count is
+// recorded on the first run, and lowered whenever c reports fewer findings, so that
+// the number of findings can only decrease. Counts are keyed by checker name, as
+// used by Group, so a single file can be shared by several checkers. When the
+// count is exceeded, all findings are returned, followed by a summary.
+//
+// Runs in which c returns an operational error are not recorded.
+//
+//    lint.NoWorse("lint_counts.json", golint.Check{})
+func NoWorse(statePath string, c Checker) Checker {
+	return noWorse{statePath: statePath, wrapped: wrapped{c}}
+}
+
+func (n noWorse) Check(pkgs ...string) error {
+	err := n.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	if ops != nil {
+		return err
+	}
+	counts, serr := n.read()
+	if serr != nil {
+		return checkers.Operational(serr)
+	}
+	name := checkerName(n.checker)
+	errs := findings(found)
+	recorded, ok := counts[name]
+	switch {
+	case ok && len(errs) > recorded:
+		return checkers.Error(append(errs, fmt.Sprintf("lint.NoWorse: %d findings, %d more than the %d recorded in %s",
+			len(errs), len(errs)-recorded, recorded, n.statePath))...)
+	case ok && len(errs) == recorded:
+		return nil
+	}
+	counts[name] = len(errs)
+	if serr = n.write(counts); serr != nil {
+		return checkers.Operational(serr)
+	}
+	return nil
+}
+
+// read returns the counts recorded in the state file, or no counts if it does not
+// exist.
+func (n noWorse) read() (map[string]int, error) {
+	counts := map[string]int{}
+	data, err := ioutil.ReadFile(n.statePath)
+	if os.IsNotExist(err) {
+		return counts, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read finding counts: %v", err)
+	}
+	if err = json.Unmarshal(data, &counts); err != nil {
+		return nil, fmt.Errorf("failed to parse finding counts in %s: %v", n.statePath, err)
+	}
+	return counts, nil
+}
+
+func (n noWorse) write(counts map[string]int) error {
+	data, err := json.MarshalIndent(counts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to format finding counts: %v", err)
+	}
+	data = append(data, '\n')
+	if _, err = os.Stat(n.statePath); os.IsNotExist(err) {
+		if err = ioutil.WriteFile(n.statePath, data, 0644); err != nil {
+			return fmt.Errorf("failed to write finding counts: %v", err)
+		}
+		return nil
+	}
+	return checkers.WriteFile(n.statePath, data)
+}
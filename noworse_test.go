@@ -0,0 +1,58 @@
+package lint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestNoWorse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "noworse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	state := filepath.Join(dir, "counts.json")
+	stored := func() string {
+		data, err := ioutil.ReadFile(state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	two := lint.Stub("a.go:1:1: x", "a.go:2:1: y")
+
+	// The first run records the count.
+	assert(t, lint.NoWorse(state, two).Check() == nil, "expected no error on first run")
+	assert(t, stored() == "{\n  \"lint.Stub\": 2\n}\n", stored())
+
+	// An increase is reported along with all findings.
+	err = lint.NoWorse(state, lint.Stub("a.go:1:1: x", "a.go:2:1: y", "a.go:3:1: z")).Check()
+	expected := []string{"a.go:1:1: x", "a.go:2:1: y", "a.go:3:1: z",
+		"lint.NoWorse: 3 findings, 1 more than the 2 recorded in " + state}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+	assert(t, stored() == "{\n  \"lint.Stub\": 2\n}\n", stored())
+
+	// A decrease lowers the stored count.
+	assert(t, lint.NoWorse(state, lint.Stub("a.go:1:1: x")).Check() == nil, "expected no error on decrease")
+	assert(t, stored() == "{\n  \"lint.Stub\": 1\n}\n", stored())
+	err = lint.NoWorse(state, two).Check()
+	assert(t, len(errorList(err)) == 3, fmt.Sprintf("%v", err))
+
+	// Other checkers are recorded separately and operational errors are not recorded.
+	assert(t, lint.NoWorse(state, twoErrors).Check() == nil, "expected no error for a new checker")
+	err = lint.NoWorse(state, lint.StubError(fmt.Errorf("not installed"))).Check()
+	assert(t, err != nil && err.Error() == "not installed", fmt.Sprintf("%v", err))
+	assert(t, stored() == "{\n  \"lint.Stub\": 1,\n  \"lint_test.checkFn\": 2\n}\n", stored())
+
+	if err = ioutil.WriteFile(state, []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, ops := lint.Split(lint.NoWorse(state, two).Check())
+	assert(t, ops != nil, "expected an operational error for a corrupt state file")
+}
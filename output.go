@@ -0,0 +1,28 @@
+package lint
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// ParseOutput reads output previously captured from a linter run by checker and
+// returns the findings in it as they would be returned by Group.Check, prefixed
+// with checker. checker is the name used by Group, such as errcheck.Check.
+//
+// This allows output captured from linters, such as in CI, to be processed later
+// using Skip, Report or other functions in this package. It supports linters which
+// print a finding per line, which is all checkers using checkers.Lint.
+func ParseOutput(checker string, r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return checkers.Operational(fmt.Errorf("failed to read output of %s: %v", checker, err))
+	}
+	var errs []string
+	for _, line := range checkers.OutputLines(string(data)) {
+		errs = append(errs, checker+": "+line)
+	}
+	return checkers.Error(errs...)
+}
@@ -0,0 +1,51 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, fmt.Errorf("read failed") }
+
+func TestParseOutput(t *testing.T) {
+	for _, test := range []struct {
+		checker  string
+		output   string
+		findings []string
+	}{
+		{
+			checker: "errcheck.Check",
+			output:  "/src/p/a.go:8:9:\tf.Close()\n/src/p/b.go:12:2:\tw.Write(data)\n",
+			findings: []string{
+				"/src/p/a.go:8:9:\tf.Close()",
+				"/src/p/b.go:12:2:\tw.Write(data)",
+			},
+		},
+		{
+			checker: "golint.Check",
+			output: "\n/src/p/a.go:3:1: exported function Run should have comment or be unexported\n\n" +
+				"/src/p/a.go:9:6: func name will be used as p.PFunc by other packages\n",
+			findings: []string{
+				"/src/p/a.go:3:1: exported function Run should have comment or be unexported",
+				"/src/p/a.go:9:6: func name will be used as p.PFunc by other packages",
+			},
+		},
+	} {
+		err := lint.ParseOutput(test.checker, strings.NewReader(test.output))
+		live := lint.Group{lint.Stub(test.findings...).WithName(test.checker)}.Check("./...")
+		assert(t, reflect.DeepEqual(errorList(err), errorList(live)), fmt.Sprintf("%s: %q != %q", test.checker, err, live))
+		assert(t, strings.HasPrefix(errorList(err)[0], test.checker+": /src/p/a.go:"), fmt.Sprintf("%v", err))
+	}
+
+	assert(t, lint.ParseOutput("errcheck.Check", strings.NewReader("\n")) == nil, "expected no findings")
+	err := lint.ParseOutput("errcheck.Check", failingReader{})
+	_, ok := err.(checkers.OperationalError)
+	assert(t, ok && strings.Contains(err.Error(), "read failed"), fmt.Sprintf("%v", err))
+}
@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"go/parser"
+	"go/scanner"
+	"go/token"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type parseGate Group
+
+// ParseGate returns a Checker that parses the .go files in each package before
+// applying the checkers in g. Syntax errors are reported as findings of lint.ParseGate
+// and packages containing them are not passed to g, as checkers would only repeat the
+// parse failure. All other packages are checked as done by Group.Check.
+func ParseGate(g Group) Checker {
+	return parseGate(g)
+}
+
+// Name implements Named.
+func (p parseGate) Name() string { return "lint.ParseGate" }
+
+func (p parseGate) Check(pkgs ...string) error {
+	var errs, valid []string
+	for _, pkg := range pkgs {
+		loaded, err := checkers.Load(pkg)
+		if err != nil {
+			return checkers.Operational(err)
+		}
+		var parsed []string
+		broken := false
+		for _, sub := range loaded.Pkgs {
+			files, err := checkers.GoFiles(sub)
+			if err != nil {
+				return err
+			}
+			syntax := syntaxErrors(files)
+			for _, e := range syntax {
+				errs, broken = append(errs, e), true
+			}
+			if len(syntax) == 0 && len(files) > 0 {
+				parsed = append(parsed, sub)
+			}
+		}
+		if !broken {
+			// Keep the package as provided, such as ./..., if it can be checked as a whole.
+			parsed = []string{pkg}
+		}
+		valid = append(valid, parsed...)
+	}
+	if len(valid) == 0 {
+		return checkers.Error(errs...)
+	}
+	var res groupErrors
+	res.addFindings(errs...)
+	res.add("", Group(p).Check(valid...))
+	return res.err()
+}
+
+// syntaxErrors returns the syntax errors in files.
+func syntaxErrors(files []string) []string {
+	var errs []string
+	for _, f := range files {
+		_, err := parser.ParseFile(token.NewFileSet(), f, nil, 0)
+		switch perr := err.(type) {
+		case nil:
+		case scanner.ErrorList:
+			for _, e := range perr {
+				errs = append(errs, e.Error())
+			}
+		default:
+			errs = append(errs, perr.Error())
+		}
+	}
+	return errs
+}
@@ -0,0 +1,63 @@
+package lint_test
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sridharv/fakegopath"
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+func TestParseGate(t *testing.T) {
+	tmp, err := fakegopath.NewTemporaryWithFiles("parsegate", []fakegopath.SourceFile{
+		{Content: []byte("package good\n"), Dest: filepath.Join("parsegate", "good", "good.go")},
+		{Content: []byte("package bad\n\nfunc {\n"), Dest: filepath.Join("parsegate", "bad", "bad.go")},
+	})
+	if err != nil {
+		t.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+
+	var checked [][]string
+	record := checkFn(func(pkgs ...string) error {
+		checked = append(checked, pkgs)
+		return fmt.Errorf("parse failure")
+	})
+	err = lint.ParseGate(lint.Group{record, record, record}).Check("parsegate/...")
+	errs := errorList(err)
+	assert(t, len(errs) == 4, fmt.Sprintf("%v", err))
+	assert(t, strings.Contains(errs[0], "bad.go:3:6: expected"), errs[0])
+	for _, e := range errs[1:] {
+		assert(t, e == "lint_test.checkFn: parse failure", e)
+	}
+	expected := [][]string{{"parsegate/good"}, {"parsegate/good"}, {"parsegate/good"}}
+	assert(t, reflect.DeepEqual(checked, expected), fmt.Sprintf("%v", checked))
+
+	// Only the syntax error is reported if no package parses.
+	checked = nil
+	err = lint.ParseGate(lint.Group{record, record}).Check("parsegate/bad")
+	assert(t, len(errorList(err)) == 1 && strings.Contains(err.Error(), "bad.go:3:6"), fmt.Sprintf("%v", err))
+	assert(t, checked == nil, fmt.Sprintf("%v", checked))
+
+	// Packages that parse are passed through unchanged.
+	checked = nil
+	err = lint.ParseGate(lint.Group{record}).Check("parsegate/good")
+	assert(t, reflect.DeepEqual(errorList(err), []string{"lint_test.checkFn: parse failure"}), fmt.Sprintf("%v", err))
+	assert(t, reflect.DeepEqual(checked, [][]string{{"parsegate/good"}}), fmt.Sprintf("%v", checked))
+
+	// Within a Group, syntax errors are prefixed with the name of the gate only once.
+	files, err := checkers.GoFiles("parsegate/bad")
+	if err != nil || len(files) != 1 {
+		t.Fatalf("failed to find bad.go: %v, %v", files, err)
+	}
+	_, perr := parser.ParseFile(token.NewFileSet(), files[0], nil, 0)
+	grouped := []string{"lint.ParseGate: " + perr.Error(), "lint.ParseGate: lint_test.checkFn: parse failure"}
+	err = lint.Group{lint.ParseGate(lint.Group{record})}.Check("parsegate/...")
+	assert(t, reflect.DeepEqual(errorList(err), grouped), fmt.Sprintf("%q", errorList(err)))
+}
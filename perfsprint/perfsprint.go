@@ -0,0 +1,33 @@
+// Package perfsprint provides lint integration for the perfsprint linter
+package perfsprint
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the perfsprint linter (https://github.com/catenacyber/perfsprint)
+type Check struct {
+	// Command sets the environment and working directory used to run perfsprint
+	checkers.Command
+	// NoIntConversion disables reporting fmt.Sprintf calls which can be replaced
+	// by integer conversions, such as strconv.Itoa, which perfsprint reports by
+	// default.
+	NoIntConversion bool
+	// ErrError reports fmt.Sprintf("%s", err) calls which could be err.Error()
+	ErrError bool
+}
+
+// Check runs perfsprint and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "perfsprint", "", "github.com/catenacyber/perfsprint", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for perfsprint
+func (c Check) Args() []string {
+	var args []string
+	if c.NoIntConversion {
+		args = append(args, "-int-conversion=false")
+	}
+	if c.ErrError {
+		args = append(args, "-err-error")
+	}
+	return args
+}
@@ -0,0 +1,61 @@
+package perfsprint_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/perfsprint"
+	"github.com/surullabs/lint/testutil"
+)
+
+const sprintf = `package perfsprinttest
+
+import "fmt"
+
+// Format is a test function
+func Format(n int) string {
+	return fmt.Sprintf("%d", n)
+}
+`
+
+func TestPerfsprint(t *testing.T) {
+	testutil.Test(t, "perfsprinttest", []testutil.StaticCheckTest{
+		{
+			Checker: perfsprint.Check{},
+			Content: []byte(`package perfsprinttest
+
+import "strconv"
+
+// Format is a test function
+func Format(n int) string {
+	return strconv.Itoa(n)
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  perfsprint.Check{},
+			Content:  []byte(sprintf),
+			Validate: testutil.Contains("fmt.Sprintf can be replaced with faster strconv.Itoa"),
+		},
+		{
+			Checker:  perfsprint.Check{NoIntConversion: true},
+			Content:  []byte(sprintf),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  perfsprint.Check{},
+			Content:  []byte(sprintf),
+			Validate: testutil.SkippedErrors(`fmt\.Sprintf can be replaced`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: perfsprint.Check{}, Expected: nil},
+		{A: perfsprint.Check{NoIntConversion: true}, Expected: []string{"-int-conversion=false"}},
+		{A: perfsprint.Check{ErrError: true}, Expected: []string{"-err-error"}},
+		{A: perfsprint.Check{NoIntConversion: true, ErrError: true}, Expected: []string{"-int-conversion=false", "-err-error"}},
+	})
+}
@@ -0,0 +1,52 @@
+package lint
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type pipeTo struct {
+	wrapped
+	command string
+	args    []string
+}
+
+// PipeTo returns a Checker that runs c and writes its findings, one per line, to
+// the standard input of command, run with args. Each non-empty line printed by
+// command on standard output is returned as a finding. No findings are reported if
+// command prints nothing. Operational errors returned by c are retained. If command
+// fails, the findings of c are returned unchanged along with an operational error
+// for the failure. command is not run if c
+// does not report any findings.
+//
+//    lint.PipeTo("./scripts/annotate.sh", nil, c)
+func PipeTo(command string, args []string, c Checker) Checker {
+	return pipeTo{command: command, args: args, wrapped: wrapped{c}}
+}
+
+func (p pipeTo) Check(pkgs ...string) error {
+	err := p.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	var errs []string
+	if lines := findings(found); len(lines) > 0 {
+		cmd := exec.Command(p.command, p.args...)
+		cmd.Stdin = strings.NewReader(strings.Join(lines, "\n") + "\n")
+		res, err := checkers.Exec(cmd)
+		if err != nil {
+			failed := fmt.Errorf("%s failed: %v: %s", p.command, err, strings.TrimSpace(res.Stderr))
+			return withOps(lines, ops, failed)
+		}
+		for _, line := range strings.Split(res.Stdout, "\n") {
+			if line = strings.TrimRight(line, "\r"); strings.TrimSpace(line) != "" {
+				errs = append(errs, line)
+			}
+		}
+	}
+	return withOps(errs, ops)
+}
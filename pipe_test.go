@@ -0,0 +1,40 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestPipeTo(t *testing.T) {
+	upper := []string{"a-z", "A-Z"}
+	err := lint.PipeTo("tr", upper, lint.Stub("a.go:1:1: first", "b.go:2:1: second")).Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), []string{"A.GO:1:1: FIRST", "B.GO:2:1: SECOND"}), fmt.Sprintf("%q", errorList(err)))
+
+	// A formatter printing nothing reports no findings.
+	assert(t, lint.PipeTo("true", nil, lint.Stub("a.go:1:1: first")).Check() == nil, "expected no error")
+
+	// The formatter is not run without findings.
+	assert(t, lint.PipeTo("false", nil, lint.Stub()).Check() == nil, "expected no error")
+
+	broken := []string{"-c", "echo broken >&2; exit 3"}
+	err = lint.PipeTo("sh", broken, lint.Stub("a.go:1:1: first")).Check()
+	found, ops := lint.Split(err)
+	assert(t, reflect.DeepEqual(errorList(found), []string{"a.go:1:1: first"}), fmt.Sprintf("%v", found))
+	assert(t, reflect.DeepEqual(errorList(ops), []string{"sh failed: exit status 3: broken"}), fmt.Sprintf("%v", ops))
+
+	// Operational errors of the wrapped checker are kept when the command fails.
+	missing := checkFn(func(...string) error {
+		return lint.Group{lint.Stub("a.go:1:1: first"), missingTool}.Check()
+	})
+	found, ops = lint.Split(lint.PipeTo("sh", broken, missing).Check())
+	assert(t, reflect.DeepEqual(errorList(found), []string{"lint.Stub: a.go:1:1: first"}), fmt.Sprintf("%v", found))
+	assert(t, reflect.DeepEqual(errorList(ops), []string{
+		"lint_test.checkFn: failed to find binary: missing", "sh failed: exit status 3: broken",
+	}), fmt.Sprintf("%v", ops))
+
+	err = lint.PipeTo("tr", upper, lint.StubError(fmt.Errorf("not installed"))).Check()
+	assert(t, err != nil && err.Error() == "not installed", fmt.Sprintf("%v", err))
+}
@@ -0,0 +1,72 @@
+package lint
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Platform is a target operating system and architecture, as set using GOOS and
+// GOARCH.
+type Platform struct {
+	GOOS   string
+	GOARCH string
+}
+
+// String returns p in the form GOOS/GOARCH, such as windows/amd64.
+func (p Platform) String() string { return p.GOOS + "/" + p.GOARCH }
+
+type forPlatforms struct {
+	wrapped
+	platforms []Platform
+}
+
+// ForPlatforms returns a Checker that runs c once for each of platforms, so that
+// files which are only built for some platforms, such as a_windows.go, are checked.
+// Each finding is prefixed with the platforms that reported it, such as
+//
+//    platform=linux/amd64|windows/amd64: a.go:12: unchecked error
+//
+// so that identical findings reported for several platforms are only returned once.
+//
+// c must embed checkers.Command, which is used to set GOOS and GOARCH for the
+// linter, replacing any values in its Env. Other settings, such as GOFLAGS, are
+// kept. c may be a struct or a pointer to one, which is not modified. An
+// OperationalError is returned for other checkers, including those wrapped by
+// another Checker.
+//
+//    lint.ForPlatforms([]lint.Platform{{"linux", "amd64"}, {"windows", "amd64"}}, errcheck.Check{})
+func ForPlatforms(platforms []Platform, c Checker) Checker {
+	return forPlatforms{platforms: platforms, wrapped: wrapped{c}}
+}
+
+func (f forPlatforms) Check(pkgs ...string) error {
+	var variants []variant
+	for _, p := range f.platforms {
+		c, err := withPlatform(f.checker, p)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("%s does not support platforms: %v", checkerName(f.checker), err))
+		}
+		variants = append(variants, variant{label: p.String(), checker: c})
+	}
+	return checkVariants("platform=", variants, pkgs)
+}
+
+// withPlatform returns a copy of c which runs its linter for p. An error is returned
+// if c does not embed checkers.Command.
+func withPlatform(c Checker, p Platform) (Checker, error) {
+	copied, err := copyChecker(c)
+	if err != nil {
+		return nil, err
+	}
+	cmd, err := settableField(copied, "Command")
+	if err != nil {
+		return nil, err
+	}
+	if !cmd.IsValid() || cmd.Type() != reflect.TypeOf(checkers.Command{}) {
+		return nil, fmt.Errorf("it does not embed checkers.Command")
+	}
+	setEnv(cmd, "GOOS="+p.GOOS, "GOARCH="+p.GOARCH)
+	return checkerOf(copied, c), nil
+}
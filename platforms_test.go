@@ -0,0 +1,92 @@
+package lint_test
+
+import (
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+// platformCheck reports each file in its WorkDir built for the platform set in its
+// environment.
+type platformCheck struct {
+	checkers.Command
+}
+
+func (c platformCheck) Check(...string) error {
+	ctx := build.Default
+	for _, e := range c.Env {
+		switch {
+		case strings.HasPrefix(e, "GOOS="):
+			ctx.GOOS = strings.TrimPrefix(e, "GOOS=")
+		case strings.HasPrefix(e, "GOARCH="):
+			ctx.GOARCH = strings.TrimPrefix(e, "GOARCH=")
+		}
+	}
+	p, err := ctx.ImportDir(c.WorkDir, 0)
+	if err != nil {
+		return checkers.Operational(err)
+	}
+	var errs []string
+	for _, f := range p.GoFiles {
+		errs = append(errs, f+":1: checked")
+	}
+	return checkers.Error(errs...)
+}
+
+// envCheck reports the environment of the embedded platformCheck.
+type envCheck struct {
+	platformCheck
+}
+
+func (c envCheck) Check(...string) error { return checkers.Error(c.Env...) }
+
+func TestForPlatforms(t *testing.T) {
+	dir, err := ioutil.TempDir("", "platforms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"a.go", "a_windows.go"} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte("package a\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	platforms := []lint.Platform{{GOOS: "linux", GOARCH: "amd64"}, {GOOS: "windows", GOARCH: "amd64"}}
+	err = lint.ForPlatforms(platforms, platformCheck{checkers.Command{WorkDir: dir}}).Check()
+	expected := []string{
+		"platform=linux/amd64|windows/amd64: a.go:1: checked",
+		"platform=windows/amd64: a_windows.go:1: checked",
+	}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	// Existing GOOS and GOARCH values are replaced and other variables are kept.
+	c := &platformCheck{checkers.Command{WorkDir: dir, Env: []string{"GOOS=plan9", "GOFLAGS=-mod=mod"}}}
+	err = lint.ForPlatforms(platforms, c).Check()
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+	assert(t, reflect.DeepEqual(c.Env, []string{"GOOS=plan9", "GOFLAGS=-mod=mod"}), fmt.Sprintf("%q", c.Env))
+	err = lint.ForPlatforms(platforms[:1], envCheck{*c}).Check()
+	assert(t, reflect.DeepEqual(errorList(err), []string{
+		"platform=linux/amd64: GOFLAGS=-mod=mod", "platform=linux/amd64: GOOS=linux", "platform=linux/amd64: GOARCH=amd64",
+	}), fmt.Sprintf("%q", errorList(err)))
+
+	err = lint.ForPlatforms(platforms, platformCheck{checkers.Command{WorkDir: filepath.Join(dir, "missing")}}).Check()
+	_, ops := lint.Split(err)
+	assert(t, ops != nil && strings.HasPrefix(errorList(ops)[0], "platform=linux/amd64: "), fmt.Sprintf("%v", err))
+
+	err = lint.ForPlatforms(platforms, twoErrors).Check()
+	_, ok := err.(checkers.OperationalError)
+	assert(t, ok && err.Error() == "lint_test.checkFn does not support platforms: it is not a struct or a pointer to a struct", fmt.Sprintf("%v", err))
+
+	err = lint.ForPlatforms(platforms, lint.Advisory(nil, platformCheck{})).Check()
+	_, ok = err.(checkers.OperationalError)
+	assert(t, ok && err.Error() == "lint_test.platformCheck does not support platforms: it does not embed checkers.Command", fmt.Sprintf("%v", err))
+}
@@ -0,0 +1,35 @@
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatPrometheus formats report as metrics in the Prometheus text exposition
+// format, which can be pushed to a Pushgateway to track lint runs over time. For
+// each checker the number of findings and the time taken to run are reported, as in
+//
+//    lint_findings_total{checker="errcheck.Check"} 5
+//    lint_run_duration_seconds{checker="errcheck.Check"} 1.2
+func FormatPrometheus(report *Report) []byte {
+	var b bytes.Buffer
+	b.WriteString("# HELP lint_findings_total Number of findings reported by a checker.\n")
+	b.WriteString("# TYPE lint_findings_total counter\n")
+	for _, r := range report.Results {
+		fmt.Fprintf(&b, "lint_findings_total{checker=\"%s\"} %d\n", promLabel(r.Checker), len(r.Findings))
+	}
+	b.WriteString("# HELP lint_run_duration_seconds Time taken to run a checker.\n")
+	b.WriteString("# TYPE lint_run_duration_seconds gauge\n")
+	for _, r := range report.Results {
+		fmt.Fprintf(&b, "lint_run_duration_seconds{checker=\"%s\"} %s\n",
+			promLabel(r.Checker), strconv.FormatFloat(r.Duration.Seconds(), 'g', -1, 64))
+	}
+	return b.Bytes()
+}
+
+// promLabel escapes a label value as required by the Prometheus text format.
+func promLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,40 @@
+package lint_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/surullabs/lint"
+)
+
+func TestFormatPrometheus(t *testing.T) {
+	report := &lint.Report{
+		Results: []lint.Result{
+			{Checker: "errcheck.Check", Findings: []string{"a.go:1:1: x", "a.go:2:1: y", "b.go:1:1: z"}, Duration: 1200 * time.Millisecond},
+			{Checker: `odd"name`, Duration: 250 * time.Millisecond},
+		},
+	}
+	expected := `# HELP lint_findings_total Number of findings reported by a checker.
+# TYPE lint_findings_total counter
+lint_findings_total{checker="errcheck.Check"} 3
+lint_findings_total{checker="odd\"name"} 0
+# HELP lint_run_duration_seconds Time taken to run a checker.
+# TYPE lint_run_duration_seconds gauge
+lint_run_duration_seconds{checker="errcheck.Check"} 1.2
+lint_run_duration_seconds{checker="odd\"name"} 0.25
+`
+	out := string(lint.FormatPrometheus(report))
+	assert(t, out == expected, out)
+
+	sample := regexp.MustCompile(`^[a-z_]+\{checker="(?:[^"\\]|\\.)*"\} [0-9.e+-]+$`)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		assert(t, strings.HasPrefix(line, "# ") || sample.MatchString(line), fmt.Sprintf("malformed line %q", line))
+	}
+
+	// Durations are recorded by Group.Report.
+	r, err := lint.Group{slow(20*time.Millisecond, lint.Stub("a.go:1:1: x"))}.Report("./...")
+	assert(t, err == nil && r.Results[0].Duration >= 20*time.Millisecond, fmt.Sprintf("%v %v", err, r))
+}
@@ -0,0 +1,20 @@
+// Package protogetter provides lint integration for the protogetter linter
+package protogetter
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the protogetter linter (https://github.com/ghostiam/protogetter)
+type Check struct {
+	// Command sets the environment and working directory used to run protogetter
+	checkers.Command
+}
+
+// Check runs protogetter and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "protogetter", "", "github.com/ghostiam/protogetter/cmd/protogetter", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for protogetter
+func (c Check) Args() []string {
+	return nil
+}
@@ -0,0 +1,77 @@
+package protogetter_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/protogetter"
+	"github.com/surullabs/lint/testutil"
+)
+
+// generated is a minimal stand in for a message generated by protoc-gen-go.
+const generated = `// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protogettertest
+
+type User struct {
+	Name string
+}
+
+func (x *User) Reset()         { *x = User{} }
+func (x *User) String() string { return x.Name }
+func (*User) ProtoMessage()    {}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+`
+
+func TestProtogetter(t *testing.T) {
+	testutil.Test(t, "protogettertest", []testutil.StaticCheckTest{
+		{
+			Checker: protogetter.Check{},
+			Content: []byte(`package protogettertest
+
+// Name is a test function
+func Name(u *User) string {
+	return u.GetName()
+}
+`),
+			Files:    map[string][]byte{"user.pb.go": []byte(generated)},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: protogetter.Check{},
+			Content: []byte(`package protogettertest
+
+// Name is a test function
+func Name(u *User) string {
+	return u.Name
+}
+`),
+			Files:    map[string][]byte{"user.pb.go": []byte(generated)},
+			Validate: testutil.Contains("avoid direct access to proto field u.Name, use u.GetName() instead"),
+		},
+		{
+			Checker: protogetter.Check{},
+			Content: []byte(`package protogettertest
+
+// Name is a test function
+func Name(u *User) string {
+	return u.Name
+}
+`),
+			Files:    map[string][]byte{"user.pb.go": []byte(generated)},
+			Validate: testutil.SkippedErrors(`avoid direct access to proto field`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: protogetter.Check{}, Expected: nil},
+	})
+}
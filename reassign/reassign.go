@@ -0,0 +1,31 @@
+// Package reassign provides lint integration for the reassign linter
+package reassign
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the reassign linter (https://github.com/curioswitch/go-reassign)
+type Check struct {
+	// Command sets the environment and working directory used to run reassign
+	checkers.Command
+	// Patterns is a list of regular expressions matching variable names to check.
+	// reassign only checks EOF and Err* variables if it is empty.
+	Patterns []string
+}
+
+// Check runs reassign and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "reassign", "", "github.com/curioswitch/go-reassign/cmd/reassign", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for reassign
+func (c Check) Args() []string {
+	var args []string
+	if len(c.Patterns) > 0 {
+		args = append(args, "-pattern", strings.Join(c.Patterns, "|"))
+	}
+	return args
+}
@@ -0,0 +1,80 @@
+package reassign_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/reassign"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestReassign(t *testing.T) {
+	testutil.Test(t, "reassigntest", []testutil.StaticCheckTest{
+		{
+			Checker: reassign.Check{},
+			Content: []byte(`package reassigntest
+
+import "io"
+
+// IsEOF is a test function
+func IsEOF(err error) bool {
+	return err == io.EOF
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: reassign.Check{},
+			Content: []byte(`package reassigntest
+
+import (
+	"errors"
+	"io"
+)
+
+// TestFunc is a test function
+func TestFunc() {
+	io.EOF = errors.New("not EOF")
+}
+`),
+			Validate: testutil.Contains("reassigning variable EOF in other package io"),
+		},
+		{
+			Checker: reassign.Check{Patterns: []string{"^Default.*"}},
+			Content: []byte(`package reassigntest
+
+import "net/http"
+
+// TestFunc is a test function
+func TestFunc() {
+	http.DefaultClient = &http.Client{}
+}
+`),
+			Validate: testutil.Contains("reassigning variable DefaultClient in other package http"),
+		},
+		{
+			Checker: reassign.Check{},
+			Content: []byte(`package reassigntest
+
+import (
+	"errors"
+	"io"
+)
+
+// TestFunc is a test function
+func TestFunc() {
+	io.EOF = errors.New("not EOF")
+}
+`),
+			Validate: testutil.SkippedErrors(`reassigning variable EOF`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: reassign.Check{}, Expected: nil},
+		{A: reassign.Check{Patterns: []string{"^Err.*"}}, Expected: []string{"-pattern", "^Err.*"}},
+		{A: reassign.Check{Patterns: []string{"^Err.*", "EOF"}}, Expected: []string{"-pattern", "^Err.*|EOF"}},
+	})
+}
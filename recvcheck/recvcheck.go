@@ -0,0 +1,41 @@
+// Package recvcheck provides lint integration for the recvcheck linter
+package recvcheck
+
+import (
+	"regexp"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the recvcheck linter (https://github.com/raeperd/recvcheck) to report
+// types with both value and pointer receivers.
+type Check struct {
+	// Command sets the environment and working directory used to run recvcheck
+	checkers.Command
+}
+
+// mixedRE matches the message reported by recvcheck for a type, of the form
+//   the methods of "<type>" use pointer receiver and non-pointer receiver.
+var mixedRE = regexp.MustCompile(`the methods of "(\w+)" use pointer receiver and non-pointer receiver\.?`)
+
+// Check runs recvcheck and returns any errors found. Findings are reported as
+//   <file:line:col>: type <name> has methods with mixed value/pointer receivers
+func (c Check) Check(pkgs ...string) error {
+	err := checkers.LintCommand(c.Command, "recvcheck", "", "github.com/raeperd/recvcheck/cmd/recvcheck", pkgs, c.Args()...)
+	found, ok := err.(interface {
+		Errors() []string
+	})
+	if !ok {
+		return err
+	}
+	var errs []string
+	for _, line := range found.Errors() {
+		errs = append(errs, mixedRE.ReplaceAllString(line, "type $1 has methods with mixed value/pointer receivers"))
+	}
+	return checkers.Error(errs...)
+}
+
+// Args returns command line arguments used for recvcheck
+func (c Check) Args() []string {
+	return nil
+}
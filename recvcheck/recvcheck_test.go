@@ -0,0 +1,60 @@
+package recvcheck_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/recvcheck"
+	"github.com/surullabs/lint/testutil"
+)
+
+const mixed = `package recvchecktest
+
+// Counter is a test type
+type Counter struct {
+	n int
+}
+
+// Value is a test method
+func (c Counter) Value() int { return c.n }
+
+// Inc is a test method
+func (c *Counter) Inc() { c.n++ }
+`
+
+func TestRecvcheck(t *testing.T) {
+	testutil.Test(t, "recvchecktest", []testutil.StaticCheckTest{
+		{
+			Checker: recvcheck.Check{},
+			Content: []byte(`package recvchecktest
+
+// Counter is a test type
+type Counter struct {
+	n int
+}
+
+// Value is a test method
+func (c *Counter) Value() int { return c.n }
+
+// Inc is a test method
+func (c *Counter) Inc() { c.n++ }
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  recvcheck.Check{},
+			Content:  []byte(mixed),
+			Validate: testutil.Contains("type Counter has methods with mixed value/pointer receivers"),
+		},
+		{
+			Checker:  recvcheck.Check{},
+			Content:  []byte(mixed),
+			Validate: testutil.SkippedErrors(`mixed value/pointer receivers`),
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: recvcheck.Check{}, Expected: nil},
+	})
+}
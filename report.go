@@ -0,0 +1,222 @@
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Report holds the results of running a Group.
+type Report struct {
+	// Files holds all .go files that were checked.
+	Files []string
+	// Results holds the results of each checker in the order they were run.
+	Results []Result
+	// ByCategory holds the number of findings reported in each category.
+	ByCategory map[string]int
+	// BySeverity holds the number of findings of each severity, as determined by
+	// SeverityOf. Findings of unknown severity are not counted.
+	BySeverity map[Severity]int
+}
+
+// HasSeverityAtLeast returns true if r holds any finding of severity s or higher.
+func (r *Report) HasSeverityAtLeast(s Severity) bool {
+	for severity, n := range r.BySeverity {
+		if severity >= s && n > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Result holds the findings reported by a single Checker.
+type Result struct {
+	// Checker is the name of the checker, as used by Group.
+	Checker string
+	// Category is the category of the checker, as returned by CategoryOf.
+	Category string
+	// Findings holds all errors returned by the checker.
+	Findings []string
+	// Severities holds the severity of each of Findings, as returned by SeverityOf.
+	Severities []Severity
+	// Duration is the time taken to run the checker.
+	Duration time.Duration
+}
+
+// Report runs each checker in g for pkgs and returns a Report holding the results.
+// An error is returned only if the files in pkgs cannot be listed.
+func (g Group) Report(pkgs ...string) (*Report, error) {
+	files, err := checkers.GoFiles(pkgs...)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{Files: files, ByCategory: map[string]int{}, BySeverity: map[Severity]int{}}
+	for _, checker := range g {
+		start := time.Now()
+		err := checker.Check(pkgs...)
+		res := Result{
+			Checker:  checkerName(checker),
+			Category: CategoryOf(checker),
+			Findings: findings(err),
+			Duration: time.Since(start),
+		}
+		for _, f := range res.Findings {
+			res.Severities = append(res.Severities, SeverityOf(checker, f))
+		}
+		r.add(res)
+	}
+	return r, nil
+}
+
+// add appends res to the results in r and updates the totals.
+func (r *Report) add(res Result) {
+	r.Results = append(r.Results, res)
+	r.ByCategory[res.Category] += len(res.Findings)
+	for _, s := range res.Severities {
+		if s != SeverityUnknown {
+			r.BySeverity[s]++
+		}
+	}
+}
+
+// byFile groups findings by the file they refer to. Files are compared using
+// their absolute paths, but keyed using the path as reported in files or the
+// finding. Findings without file information are returned separately.
+func byFile(files []string, findings []string) (grouped map[string][]string, order []string, unattributed []string) {
+	grouped = map[string][]string{}
+	keys := map[string]string{}
+	add := func(f string) string {
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			abs = f
+		}
+		if key, ok := keys[abs]; ok {
+			return key
+		}
+		keys[abs] = f
+		order = append(order, f)
+		return f
+	}
+	for _, f := range files {
+		add(f)
+	}
+	for _, e := range findings {
+		f := findingFile(e)
+		if f == "" {
+			unattributed = append(unattributed, e)
+			continue
+		}
+		key := add(f)
+		grouped[key] = append(grouped[key], e)
+	}
+	return grouped, order, unattributed
+}
+
+// DedupeAcrossPackages returns a copy of report in which findings of each checker
+// that refer to the same position in the same file are reported only once. Files
+// are compared after resolving symbolic links, so that code reachable using several
+// import paths, such as symlinked or vendored packages, is only counted once. The
+// first occurrence of each finding is retained, as are findings without a position.
+func DedupeAcrossPackages(report *Report) *Report {
+	deduped := &Report{ByCategory: map[string]int{}, BySeverity: map[Severity]int{}}
+	seenFiles := map[string]bool{}
+	for _, f := range report.Files {
+		if real := realPath(f); !seenFiles[real] {
+			seenFiles[real] = true
+			deduped.Files = append(deduped.Files, f)
+		}
+	}
+	for _, res := range report.Results {
+		seen := map[string]bool{}
+		filtered := Result{Checker: res.Checker, Category: res.Category, Duration: res.Duration}
+		for i, f := range res.Findings {
+			if _, file, line, col, msg, ok := parseLabeled(f); ok {
+				key := fmt.Sprintf("%s:%d:%d: %s", realPath(file), line, col, msg)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+			filtered.Findings = append(filtered.Findings, f)
+			if i < len(res.Severities) {
+				filtered.Severities = append(filtered.Severities, res.Severities[i])
+			}
+		}
+		deduped.add(filtered)
+	}
+	return deduped
+}
+
+// realPath returns the absolute path of file with any symbolic links resolved,
+// or file itself if it cannot be resolved.
+func realPath(file string) string {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return file
+	}
+	if real, err := filepath.EvalSymlinks(abs); err == nil {
+		return real
+	}
+	return abs
+}
+
+// MergeReports combines reports, such as those produced by shards of a build, into
+// a single Report. Files are listed once, and the results of checkers with the same
+// name are combined in the order the checkers first appear. A package checked by
+// more than one shard is reported once: a finding reported by a checker in an
+// earlier report is not repeated. Totals are computed for the combined findings, and
+// the durations of each checker are added.
+func MergeReports(reports ...*Report) *Report {
+	merged := &Report{ByCategory: map[string]int{}, BySeverity: map[Severity]int{}}
+	files := map[string]bool{}
+	var results []*Result
+	index := map[string]*Result{}
+	seen := map[string]map[string]bool{}
+	for _, r := range reports {
+		for _, f := range r.Files {
+			if !files[f] {
+				files[f] = true
+				merged.Files = append(merged.Files, f)
+			}
+		}
+		current := map[string]map[string]bool{}
+		for _, res := range r.Results {
+			m := index[res.Checker]
+			if m == nil {
+				m = &Result{Checker: res.Checker, Category: res.Category}
+				index[res.Checker] = m
+				results = append(results, m)
+			}
+			m.Duration += res.Duration
+			if current[res.Checker] == nil {
+				current[res.Checker] = map[string]bool{}
+			}
+			for i, f := range res.Findings {
+				if seen[res.Checker][f] {
+					continue
+				}
+				current[res.Checker][f] = true
+				m.Findings = append(m.Findings, f)
+				s := SeverityUnknown
+				if i < len(res.Severities) {
+					s = res.Severities[i]
+				}
+				m.Severities = append(m.Severities, s)
+			}
+		}
+		for checker, fs := range current {
+			if seen[checker] == nil {
+				seen[checker] = map[string]bool{}
+			}
+			for f := range fs {
+				seen[checker][f] = true
+			}
+		}
+	}
+	for _, res := range results {
+		merged.add(*res)
+	}
+	return merged
+}
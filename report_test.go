@@ -0,0 +1,53 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestMergeReports(t *testing.T) {
+	shard1 := &lint.Report{
+		Files: []string{"a/a.go", "shared/s.go"},
+		Results: []lint.Result{
+			{
+				Checker:    "gosec.Check",
+				Category:   lint.CategorySecurity,
+				Findings:   []string{"a/a.go:1:1: [G104] Errors unhandled.", "shared/s.go:2:1: [G304] File inclusion."},
+				Severities: []lint.Severity{lint.SeverityLow, lint.SeverityMedium},
+			},
+			{Checker: "golint.Check", Category: lint.CategoryStyle, Findings: []string{"a/a.go:3:1: exported A should have comment"}},
+		},
+	}
+	shard2 := &lint.Report{
+		Files: []string{"b/b.go", "shared/s.go"},
+		Results: []lint.Result{
+			{Checker: "golint.Check", Category: lint.CategoryStyle},
+			{
+				Checker:    "gosec.Check",
+				Category:   lint.CategorySecurity,
+				Findings:   []string{"shared/s.go:2:1: [G304] File inclusion.", "b/b.go:4:1: [G101] Credentials."},
+				Severities: []lint.Severity{lint.SeverityMedium, lint.SeverityHigh},
+			},
+		},
+	}
+	merged := lint.MergeReports(shard1, shard2)
+	assert(t, reflect.DeepEqual(merged.Files, []string{"a/a.go", "shared/s.go", "b/b.go"}), fmt.Sprintf("%v", merged.Files))
+	assert(t, len(merged.Results) == 2, fmt.Sprintf("%v", merged.Results))
+	expected := []string{
+		"a/a.go:1:1: [G104] Errors unhandled.",
+		"shared/s.go:2:1: [G304] File inclusion.",
+		"b/b.go:4:1: [G101] Credentials.",
+	}
+	assert(t, merged.Results[0].Checker == "gosec.Check", merged.Results[0].Checker)
+	assert(t, reflect.DeepEqual(merged.Results[0].Findings, expected), fmt.Sprintf("%q", merged.Results[0].Findings))
+	assert(t, reflect.DeepEqual(merged.ByCategory, map[string]int{lint.CategorySecurity: 3, lint.CategoryStyle: 1}),
+		fmt.Sprintf("%v", merged.ByCategory))
+	expectedSeverities := map[lint.Severity]int{lint.SeverityLow: 1, lint.SeverityMedium: 1, lint.SeverityHigh: 1}
+	assert(t, reflect.DeepEqual(merged.BySeverity, expectedSeverities), fmt.Sprintf("%v", merged.BySeverity))
+
+	empty := lint.MergeReports()
+	assert(t, len(empty.Results) == 0 && empty.ByCategory != nil, fmt.Sprintf("%v", empty))
+}
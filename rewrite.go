@@ -0,0 +1,56 @@
+package lint
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type rewrite struct {
+	wrapped
+	re   *regexp.Regexp
+	repl string
+}
+
+// Rewrite returns a Checker that runs c and replaces matches of re in the message
+// of each finding with repl, as done by re.ReplaceAllString. The position of a
+// finding, as parsed by ParseFinding, is left unchanged. Findings without a
+// position are rewritten entirely. This can be used to redact details such as home
+// directories from output shared publicly.
+//
+//    lint.Rewrite(regexp.MustCompile(`/home/[^/]+`), "~", c)
+func Rewrite(re *regexp.Regexp, repl string, c Checker) Checker {
+	return rewrite{re: re, repl: repl, wrapped: wrapped{c}}
+}
+
+func (r rewrite) Check(pkgs ...string) error {
+	return mapFindings(r.checker.Check(pkgs...), r.replace)
+}
+
+func (r rewrite) replace(finding string) string {
+	_, _, _, _, msg, ok := parseLabeled(finding)
+	if !ok {
+		return r.re.ReplaceAllString(finding, r.repl)
+	}
+	// msg is the end of finding, apart from trailing white space.
+	i := strings.LastIndex(finding, msg)
+	if msg == "" || i < 0 {
+		return finding
+	}
+	return finding[:i] + r.re.ReplaceAllString(finding[i:], r.repl)
+}
+
+// mapFindings applies fn to each finding in err, retaining operational errors
+// unchanged.
+func mapFindings(err error, fn func(finding string) string) error {
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	var errs []string
+	for _, f := range findings(found) {
+		errs = append(errs, fn(f))
+	}
+	return withOps(errs, ops)
+}
@@ -0,0 +1,34 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestRewrite(t *testing.T) {
+	home := regexp.MustCompile(`/home/[^/ ]+`)
+	c := lint.Rewrite(home, "~", lint.Stub(
+		"/home/alice/src/p/a.go:3:2: cannot read /home/alice/.config/p.json",
+		"/home/alice/src/p/b.go:7: tab separated\t/home/alice/b",
+		"failed to load /home/alice/src/p",
+		"a.go:1: nothing to redact",
+	))
+	expected := []string{
+		"/home/alice/src/p/a.go:3:2: cannot read ~/.config/p.json",
+		"/home/alice/src/p/b.go:7: tab separated\t~/b",
+		"failed to load ~/src/p",
+		"a.go:1: nothing to redact",
+	}
+	err := c.Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	// Findings prefixed by a Group keep their label and position.
+	err = lint.Rewrite(home, "~", lint.Group{lint.Stub("/home/bob/a.go:1:1: owned by /home/bob")}).Check()
+	expected = []string{"lint.Stub: /home/bob/a.go:1:1: owned by ~"}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+	assert(t, lint.Rewrite(home, "~", lint.Stub()).Check() == nil, "expected no error")
+}
@@ -0,0 +1,31 @@
+// Package rowserrcheck provides lint integration for the rowserrcheck linter
+package rowserrcheck
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the rowserrcheck linter (https://github.com/jingyugao/rowserrcheck)
+type Check struct {
+	// Command sets the environment and working directory used to run rowserrcheck
+	checkers.Command
+	// Packages is a list of additional SQL packages whose Rows must be checked,
+	// such as github.com/jmoiron/sqlx
+	Packages []string
+}
+
+// Check runs rowserrcheck and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "rowserrcheck", "", "github.com/jingyugao/rowserrcheck", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for rowserrcheck
+func (c Check) Args() []string {
+	var args []string
+	if len(c.Packages) > 0 {
+		args = append(args, "-packages", strings.Join(c.Packages, ","))
+	}
+	return args
+}
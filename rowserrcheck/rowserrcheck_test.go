@@ -0,0 +1,74 @@
+package rowserrcheck_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/rowserrcheck"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestRowserrcheck(t *testing.T) {
+	testutil.Test(t, "rowserrchecktest", []testutil.StaticCheckTest{
+		{
+			Checker: rowserrcheck.Check{},
+			Content: []byte(`package rowserrchecktest
+
+import "database/sql"
+
+// Names is a test function
+func Names(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM users")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: rowserrcheck.Check{},
+			Content: []byte(`package rowserrchecktest
+
+import "database/sql"
+
+// Names is a test function
+func Names(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM users")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+`),
+			Validate: testutil.Contains("rows.Err must be checked"),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: rowserrcheck.Check{}, Expected: nil},
+		{A: rowserrcheck.Check{Packages: []string{"github.com/jmoiron/sqlx"}}, Expected: []string{"-packages", "github.com/jmoiron/sqlx"}},
+		{A: rowserrcheck.Check{Packages: []string{"a", "b"}}, Expected: []string{"-packages", "a,b"}},
+	})
+}
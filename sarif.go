@@ -0,0 +1,102 @@
+package lint
+
+import (
+	"encoding/json"
+	"path/filepath"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver struct {
+		Name string `json:"name"`
+	} `json:"driver"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region struct {
+			StartLine   int `json:"startLine"`
+			StartColumn int `json:"startColumn,omitempty"`
+		} `json:"region"`
+	} `json:"physicalLocation"`
+}
+
+// FormatSARIF formats report as a SARIF 2.1.0 log, as read by code scanning tools.
+// Each checker is reported as a run holding a result for each of its findings.
+// Rule identifiers, such as [G104], are reported as the rule of a result. The level
+// of a result is determined by the severity of the finding: error for high or
+// unknown, warning for medium and note for low. Findings without file information
+// are reported without a location.
+func FormatSARIF(report *Report) ([]byte, error) {
+	log := sarifLog{Version: "2.1.0", Schema: "https://json.schemastore.org/sarif-2.1.0.json", Runs: []sarifRun{}}
+	for _, r := range report.Results {
+		run := sarifRun{Results: []sarifResult{}}
+		run.Tool.Driver.Name = r.Checker
+		for i, f := range r.Findings {
+			res := sarifResult{Level: severityLevel(r, i, "error", "warning", "note"), Message: sarifMessage{Text: f}}
+			if m := ruleIDRE.FindStringSubmatch(f); m != nil {
+				res.RuleID = m[1]
+			}
+			if label, file, line, col, msg, ok := parseLabeled(f); ok {
+				var loc sarifLocation
+				loc.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(file)
+				loc.PhysicalLocation.Region.StartLine = line
+				loc.PhysicalLocation.Region.StartColumn = col
+				res.Locations, res.Message.Text = []sarifLocation{loc}, labeled(label, msg)
+			}
+			run.Results = append(run.Results, res)
+		}
+		log.Runs = append(log.Runs, run)
+	}
+	return json.MarshalIndent(log, "", "  ")
+}
+
+// severityLevel returns high, medium or low depending on the severity of the i'th
+// finding in r. Findings of unknown severity are reported as high, since they fail
+// a build like any other finding.
+func severityLevel(r Result, i int, high, medium, low string) string {
+	if i >= len(r.Severities) {
+		return high
+	}
+	switch r.Severities[i] {
+	case SeverityLow:
+		return low
+	case SeverityMedium:
+		return medium
+	default:
+		return high
+	}
+}
+
+// labeled returns msg prefixed with label, if any, as in findings reported by a
+// Group.
+func labeled(label, msg string) string {
+	if label == "" {
+		return msg
+	}
+	return label + ": " + msg
+}
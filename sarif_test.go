@@ -0,0 +1,69 @@
+package lint_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestFormatSARIF(t *testing.T) {
+	report := &lint.Report{
+		Results: []lint.Result{
+			{
+				Checker: "gosec.Check",
+				Findings: []string{
+					"pkg/a.go:7:2: [G104] Errors unhandled. (Confidence: HIGH, Severity: LOW)",
+					"tags=linux: pkg/b.go:3: [G101] Potential hardcoded credentials",
+				},
+				Severities: []lint.Severity{lint.SeverityLow, lint.SeverityHigh},
+			},
+			{Checker: "gofmt.Check", Findings: []string{"exit status 2"}},
+			{Checker: "golint.Check"},
+		},
+	}
+	data, err := lint.FormatSARIF(report)
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+
+	type location struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct{ URI string }
+			Region           struct{ StartLine, StartColumn int }
+		}
+	}
+	type result struct {
+		RuleID    string
+		Level     string
+		Message   struct{ Text string }
+		Locations []location
+	}
+	var parsed struct {
+		Version string
+		Runs    []struct {
+			Tool    struct{ Driver struct{ Name string } }
+			Results []result
+		}
+	}
+	err = json.Unmarshal(data, &parsed)
+	assert(t, err == nil, fmt.Sprintf("invalid json: %v\n%s", err, data))
+	assert(t, parsed.Version == "2.1.0" && len(parsed.Runs) == 3, string(data))
+
+	gosec := parsed.Runs[0]
+	assert(t, gosec.Tool.Driver.Name == "gosec.Check" && len(gosec.Results) == 2, string(data))
+	first, second := gosec.Results[0], gosec.Results[1]
+	assert(t, first.RuleID == "G104" && first.Level == "note", string(data))
+	assert(t, first.Message.Text == "[G104] Errors unhandled. (Confidence: HIGH, Severity: LOW)", string(data))
+	assert(t, len(first.Locations) == 1, string(data))
+	loc := first.Locations[0].PhysicalLocation
+	assert(t, loc.ArtifactLocation.URI == "pkg/a.go" && loc.Region.StartLine == 7 && loc.Region.StartColumn == 2, string(data))
+	assert(t, second.RuleID == "G101" && second.Level == "error", string(data))
+	assert(t, second.Message.Text == "tags=linux: [G101] Potential hardcoded credentials", string(data))
+
+	gofmt := parsed.Runs[1].Results
+	expected := []result{{Level: "error", Message: struct{ Text string }{"exit status 2"}}}
+	assert(t, reflect.DeepEqual(gofmt, expected), string(data))
+
+	assert(t, parsed.Runs[2].Results != nil && len(parsed.Runs[2].Results) == 0, string(data))
+}
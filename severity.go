@@ -0,0 +1,74 @@
+package lint
+
+import "regexp"
+
+// Severity is the severity of a finding.
+type Severity int
+
+// Severities in increasing order. SeverityUnknown is used for findings which
+// cannot be classified.
+const (
+	SeverityUnknown Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityLow:
+		return "low"
+	case SeverityMedium:
+		return "medium"
+	case SeverityHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// Classifier is implemented by checkers that can determine the severity of their
+// findings.
+type Classifier interface {
+	Severity(finding string) Severity
+}
+
+// severityRE matches severities reported by gosec, such as Severity: HIGH.
+var severityRE = regexp.MustCompile(`Severity: (LOW|MEDIUM|HIGH)\b`)
+
+// SeverityOf returns the severity of finding reported by c. If c implements
+// Classifier it is used. Otherwise findings which include a severity in the form
+// used by gosec, such as (Confidence: HIGH, Severity: LOW), are classified by it.
+// SeverityUnknown is returned for all other findings.
+func SeverityOf(c Checker, finding string) Severity {
+	if cl, ok := c.(Classifier); ok {
+		return cl.Severity(finding)
+	}
+	m := severityRE.FindStringSubmatch(finding)
+	if m == nil {
+		return SeverityUnknown
+	}
+	switch m[1] {
+	case "LOW":
+		return SeverityLow
+	case "MEDIUM":
+		return SeverityMedium
+	default:
+		return SeverityHigh
+	}
+}
+
+type classify struct {
+	wrapped
+	fn func(finding string) Severity
+}
+
+// Classify returns a Checker that runs c and classifies its findings using fn.
+func Classify(fn func(finding string) Severity, c Checker) Checker {
+	return classify{fn: fn, wrapped: wrapped{c}}
+}
+
+// Severity implements Classifier.
+func (c classify) Severity(finding string) Severity { return c.fn(finding) }
+
+func (c classify) Check(pkgs ...string) error { return c.checker.Check(pkgs...) }
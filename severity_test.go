@@ -0,0 +1,43 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestReportBySeverity(t *testing.T) {
+	critical := func(finding string) lint.Severity {
+		if strings.Contains(finding, "SA5000") {
+			return lint.SeverityHigh
+		}
+		return lint.SeverityUnknown
+	}
+	report, err := lint.Group{
+		lint.Stub(
+			"a.go:1:1: [G104] Errors unhandled. (Confidence: HIGH, Severity: LOW)",
+			"a.go:2:1: [G304] File inclusion. (Confidence: HIGH, Severity: MEDIUM)",
+			"a.go:3:1: [G101] Credentials. (Confidence: LOW, Severity: HIGH)",
+			"a.go:4:1: [G104] Errors unhandled. (Confidence: HIGH, Severity: LOW)",
+		).WithName("gosec.Check"),
+		lint.Classify(critical, lint.Stub("b.go:1:1: nil map assignment (SA5000)", "b.go:2:1: unused (U1000)")),
+		twoErrors,
+	}.Report("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	expected := map[lint.Severity]int{lint.SeverityLow: 2, lint.SeverityMedium: 1, lint.SeverityHigh: 2}
+	assert(t, reflect.DeepEqual(report.BySeverity, expected), fmt.Sprintf("%v", report.BySeverity))
+	assert(t, report.HasSeverityAtLeast(lint.SeverityHigh), "expected high severity findings")
+	assert(t, report.Results[1].Checker == "lint.Stub", report.Results[1].Checker)
+
+	report, err = lint.Group{
+		lint.Stub("a.go:1:1: [G104] Errors unhandled. (Confidence: HIGH, Severity: LOW)"),
+		twoErrors,
+	}.Report("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, report.HasSeverityAtLeast(lint.SeverityLow), "expected low severity findings")
+	assert(t, !report.HasSeverityAtLeast(lint.SeverityMedium), fmt.Sprintf("%v", report.BySeverity))
+	assert(t, lint.SeverityMedium.String() == "medium", lint.SeverityMedium.String())
+}
@@ -1,7 +1,10 @@
 package lint
 
 import (
+	"go/build"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/surullabs/lint/checkers"
 )
@@ -57,16 +60,12 @@ func Skip(err error, skippers ...Skipper) error {
 		return nil
 	case errors:
 		var n []string
-		errs := serr.Errors()
-		for _, e := range errs {
+		for _, e := range serr.Errors() {
 			if !skip(e, skippers) {
 				n = append(n, e)
 			}
 		}
-		if len(n) == 0 {
-			return nil
-		}
-		return checkers.Error(n...)
+		return Join(n)
 	default:
 		if skip(serr.Error(), skippers) {
 			return nil
@@ -75,6 +74,48 @@ func Skip(err error, skippers ...Skipper) error {
 	}
 }
 
+// SkipUnmatched is the error added by a Checker returned by StrictSkip when its
+// Skipper did not skip any findings.
+const SkipUnmatched = "skip pattern matched no findings"
+
+type strictSkip struct {
+	wrapped
+	skipper Skipper
+}
+
+// StrictSkip returns a Checker that runs c and skips findings using s, as done by
+// Skip. If s skips none of the findings, SkipUnmatched is reported in addition to
+// the remaining findings. This allows skip rules that are no longer needed to be
+// found and removed. SkipUnmatched is not reported if c returned an operational
+// error, since findings may be missing.
+func StrictSkip(s Skipper, c Checker) Checker {
+	return strictSkip{skipper: s, wrapped: wrapped{c}}
+}
+
+func (s strictSkip) Check(pkgs ...string) error {
+	err := s.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	var errs []string
+	matched := false
+	for _, f := range findings(found) {
+		if s.skipper.Skip(f) {
+			matched = true
+			continue
+		}
+		errs = append(errs, f)
+	}
+	if ops != nil {
+		return withOps(errs, ops)
+	}
+	if !matched {
+		errs = append(errs, SkipUnmatched)
+	}
+	return checkers.Error(errs...)
+}
+
 // RegexpMatch returns a Skipper that skips all errors which match
 // any of the provided regular expression patterns. SkipRegexpMatch expects
 // all patterns to be valid regexps and panics otherwise.
@@ -90,3 +131,121 @@ func RegexpMatch(regexps ...string) Skipper {
 		},
 	}
 }
+
+// SkipPackages returns a Skipper that skips all errors reported for files in
+// any of the packages identified by import paths. A path ending in /... also
+// skips all sub packages. Errors without file information are not skipped.
+//
+// The package of a file is determined using go/build and so must be in
+// the GOPATH.
+func SkipPackages(paths ...string) Skipper {
+	return StringSkipper{
+		Strings: paths,
+		Matcher: func(errstr, path string) bool {
+			file := findingFile(errstr)
+			if file == "" {
+				return false
+			}
+			return matchPackage(filePackage(file), path)
+		},
+	}
+}
+
+// filePackage returns the import path of the package containing file or an
+// empty string if it cannot be determined.
+func filePackage(file string) string {
+	dir, err := filepath.Abs(filepath.Dir(file))
+	if err != nil {
+		return ""
+	}
+	pkg, err := build.ImportDir(dir, build.FindOnly)
+	if err != nil || pkg.ImportPath == "." {
+		return ""
+	}
+	return pkg.ImportPath
+}
+
+// matchPackage returns true if pkg is path or a sub package of a path ending in /...
+func matchPackage(pkg, path string) bool {
+	if pkg == "" {
+		return false
+	}
+	if !strings.HasSuffix(path, "/...") {
+		return pkg == path
+	}
+	root := strings.TrimSuffix(path, "/...")
+	return pkg == root || strings.HasPrefix(pkg, root+"/")
+}
+
+type ruleInFile struct {
+	rule, pattern string
+}
+
+// SkipRuleInFile returns a Skipper that skips findings reporting ruleID, such as
+// G104 or SA1000, for files matching filePattern. The rule is identified by a
+// marker of the form (G104) or [G104] in the finding. filePattern uses the syntax
+// of filepath.Match and is matched against the base name of the file, or the full
+// path as reported if it contains a path separator.
+//
+//    lint.SkipRuleInFile("G104", "main.go")
+func SkipRuleInFile(ruleID, filePattern string) Skipper {
+	return ruleInFile{rule: ruleID, pattern: filePattern}
+}
+
+func (r ruleInFile) Skip(finding string) bool {
+	file := findingFile(finding)
+	if file == "" {
+		return false
+	}
+	ids := ruleIDRE.FindAllStringSubmatch(finding, -1)
+	if ids == nil || ids[len(ids)-1][1] != r.rule {
+		return false
+	}
+	return matchFile(r.pattern, file)
+}
+
+// matchFile returns true if file matches pattern, using the syntax of filepath.Match.
+// pattern is matched against the base name of file, or the full path if it contains
+// a path separator.
+func matchFile(pattern, file string) bool {
+	name := filepath.Base(file)
+	if strings.Contains(pattern, "/") || strings.ContainsRune(pattern, filepath.Separator) {
+		name = file
+	}
+	matched, err := filepath.Match(pattern, name)
+	return err == nil && matched
+}
+
+type skipAll []Skipper
+
+func (s skipAll) Skip(finding string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for _, skipper := range s {
+		if !skipper.Skip(finding) {
+			return false
+		}
+	}
+	return true
+}
+
+// And returns a Skipper that skips a finding if all of skippers skip it. As with
+// Or, no findings are skipped if skippers is empty.
+func And(skippers ...Skipper) Skipper { return skipAll(skippers) }
+
+type skipAny []Skipper
+
+func (s skipAny) Skip(finding string) bool { return skip(finding, s) }
+
+// Or returns a Skipper that skips a finding if any of skippers skips it.
+func Or(skippers ...Skipper) Skipper { return skipAny(skippers) }
+
+type skipNot struct {
+	skipper Skipper
+}
+
+func (s skipNot) Skip(finding string) bool { return !s.skipper.Skip(finding) }
+
+// Not returns a Skipper that skips a finding if s does not skip it.
+func Not(s Skipper) Skipper { return skipNot{skipper: s} }
@@ -0,0 +1,58 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestSkipPackages(t *testing.T) {
+	const errcheckFinding = "errcheck/errcheck.go:17:1: some finding"
+	testutil.TestSkips(t, []testutil.SkipTest{
+		{S: lint.SkipPackages("github.com/surullabs/lint/errcheck"), Line: errcheckFinding, Skip: true},
+		{S: lint.SkipPackages("github.com/surullabs/lint/errcheck"), Line: "errcheck.Check: " + errcheckFinding, Skip: true},
+		{S: lint.SkipPackages("github.com/surullabs/lint/..."), Line: errcheckFinding, Skip: true},
+		{S: lint.SkipPackages("github.com/surullabs/lint/..."), Line: "lint.go:1: root package", Skip: true},
+		{S: lint.SkipPackages("github.com/surullabs/lint/err..."), Line: errcheckFinding, Skip: false},
+		{S: lint.SkipPackages("github.com/surullabs/lint/golint"), Line: errcheckFinding, Skip: false},
+		{S: lint.SkipPackages("github.com/surullabs/lint"), Line: errcheckFinding, Skip: false},
+		{S: lint.SkipPackages("github.com/surullabs/lint/..."), Line: "no file information", Skip: false},
+		{S: lint.SkipPackages("github.com/surullabs/lint/golint", "github.com/surullabs/lint/errcheck"), Line: errcheckFinding, Skip: true},
+	})
+}
+
+func TestSkipRuleInFile(t *testing.T) {
+	testutil.TestSkips(t, []testutil.SkipTest{
+		{S: lint.SkipRuleInFile("G104", "main.go"), Line: "cmd/main.go:12:2: [G104] Errors unhandled.", Skip: true},
+		{S: lint.SkipRuleInFile("G104", "main.go"), Line: "gosec.Check: cmd/main.go:12:2: Errors unhandled. (G104)", Skip: true},
+		{S: lint.SkipRuleInFile("G104", "main.go"), Line: "cmd/main.go:14:2: [G304] File inclusion.", Skip: false},
+		{S: lint.SkipRuleInFile("G104", "main.go"), Line: "pkg/server.go:12:2: [G104] Errors unhandled.", Skip: false},
+		{S: lint.SkipRuleInFile("G104", "main.go"), Line: "[G104] Errors unhandled in main.go", Skip: false},
+		{S: lint.SkipRuleInFile("SA1000", "*_gen.go"), Line: "p/a_gen.go:3:1: bad regexp (SA1000)", Skip: true},
+		{S: lint.SkipRuleInFile("SA1000", "cmd/*.go"), Line: "cmd/a.go:3:1: bad regexp (SA1000)", Skip: true},
+		{S: lint.SkipRuleInFile("SA1000", "cmd/*.go"), Line: "pkg/cmd/a.go:3:1: bad regexp (SA1000)", Skip: false},
+	})
+}
+
+func TestStrictSkip(t *testing.T) {
+	c := lint.Stub("a.go:1:1: generated", "b.go:2:1: real")
+	err := lint.StrictSkip(lint.RegexpMatch("generated"), c).Check()
+	assert(t, reflect.DeepEqual(errorList(err), []string{"b.go:2:1: real"}), fmt.Sprintf("%q", errorList(err)))
+
+	err = lint.StrictSkip(lint.RegexpMatch("generated"), lint.Stub("a.go:1:1: generated")).Check()
+	assert(t, err == nil, fmt.Sprintf("expected no error, got %v", err))
+
+	err = lint.StrictSkip(lint.RegexpMatch("stale"), c).Check()
+	expected := []string{"a.go:1:1: generated", "b.go:2:1: real", lint.SkipUnmatched}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	err = lint.StrictSkip(lint.RegexpMatch("stale"), lint.Stub()).Check()
+	assert(t, reflect.DeepEqual(errorList(err), []string{lint.SkipUnmatched}), fmt.Sprintf("%q", errorList(err)))
+
+	// Operational errors may hide findings, so no unmatched error is reported.
+	err = lint.StrictSkip(lint.RegexpMatch("stale"), lint.StubError(fmt.Errorf("not installed"))).Check()
+	assert(t, err != nil && err.Error() == "not installed", fmt.Sprintf("%v", err))
+}
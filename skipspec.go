@@ -0,0 +1,240 @@
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseSkip returns the Skipper described by spec, which allows skip rules to be
+// read from configuration. For example
+//
+//    file:vendor/** or (rule:SA1000 and file:main.go)
+//
+// spec uses the grammar
+//
+//    expr   = term { "or" term }
+//    term   = factor { "and" factor }
+//    factor = "not" factor | "(" expr ")" | match
+//    match  = kind ":" value
+//
+// so that not binds tighter than and, which binds tighter than or. The kinds of
+// match are
+//
+//    file:<pattern>  findings for files matching pattern, as done by SkipRuleInFile.
+//                    A pattern of the form dir/** matches all files below dir,
+//                    which is relative to the current directory unless absolute.
+//    rule:<id>       findings reporting the rule id, such as SA1000 or G104.
+//    pkg:<path>      findings in the package path, as done by SkipPackages.
+//    regexp:<re>     findings matching the regular expression re.
+//
+// A value ends at white space or a parenthesis. Values containing these may be
+// written as a double quoted Go string, such as regexp:"unused (var|const)".
+func ParseSkip(spec string) (Skipper, error) {
+	tokens, err := skipTokens(spec)
+	if err != nil {
+		return nil, err
+	}
+	p := &skipParser{tokens: tokens}
+	s, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("invalid skip spec %q: unexpected %q", spec, p.tokens[p.pos])
+	}
+	return s, nil
+}
+
+// skipTokens splits spec into parentheses, keywords and matches.
+func skipTokens(spec string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(spec); {
+		switch c := rune(spec[i]); {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			start := i
+			for i < len(spec) && !unicode.IsSpace(rune(spec[i])) && spec[i] != '(' && spec[i] != ')' {
+				if spec[i] != '"' {
+					i++
+					continue
+				}
+				// Skip to the end of the quoted string, allowing escaped quotes.
+				for i++; i < len(spec) && spec[i] != '"'; i++ {
+					if spec[i] == '\\' {
+						i++
+					}
+				}
+				if i >= len(spec) {
+					return nil, fmt.Errorf("invalid skip spec %q: unterminated string", spec)
+				}
+				i++
+			}
+			tokens = append(tokens, spec[start:i])
+		}
+	}
+	return tokens, nil
+}
+
+type skipParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *skipParser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *skipParser) expr() (Skipper, error) {
+	s, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	skippers := []Skipper{s}
+	for p.next() == "or" {
+		p.pos++
+		if s, err = p.term(); err != nil {
+			return nil, err
+		}
+		skippers = append(skippers, s)
+	}
+	if len(skippers) == 1 {
+		return skippers[0], nil
+	}
+	return Or(skippers...), nil
+}
+
+func (p *skipParser) term() (Skipper, error) {
+	s, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	skippers := []Skipper{s}
+	for p.next() == "and" {
+		p.pos++
+		if s, err = p.factor(); err != nil {
+			return nil, err
+		}
+		skippers = append(skippers, s)
+	}
+	if len(skippers) == 1 {
+		return skippers[0], nil
+	}
+	return And(skippers...), nil
+}
+
+func (p *skipParser) factor() (Skipper, error) {
+	switch tok := p.next(); tok {
+	case "":
+		return nil, fmt.Errorf("invalid skip spec: unexpected end")
+	case "not":
+		p.pos++
+		s, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		return Not(s), nil
+	case "(":
+		p.pos++
+		s, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("invalid skip spec: missing )")
+		}
+		p.pos++
+		return s, nil
+	default:
+		p.pos++
+		return skipMatch(tok)
+	}
+}
+
+// skipMatch returns the Skipper for a single kind:value match.
+func skipMatch(tok string) (Skipper, error) {
+	i := strings.Index(tok, ":")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid skip spec: unexpected %q", tok)
+	}
+	kind, value := tok[:i], tok[i+1:]
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid skip spec: bad string in %q: %v", tok, err)
+		}
+		value = unquoted
+	}
+	if value == "" {
+		return nil, fmt.Errorf("invalid skip spec: missing value in %q", tok)
+	}
+	switch kind {
+	case "file":
+		return fileMatch(value), nil
+	case "rule":
+		return ruleMatch(value), nil
+	case "pkg":
+		return SkipPackages(value), nil
+	case "regexp":
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid skip spec: bad regexp in %q: %v", tok, err)
+		}
+		return skipFunc(re.MatchString), nil
+	}
+	return nil, fmt.Errorf("invalid skip spec: unknown kind %q in %q", kind, tok)
+}
+
+type skipFunc func(finding string) bool
+
+func (s skipFunc) Skip(finding string) bool { return s(finding) }
+
+// fileMatch skips findings for files matching a file: pattern.
+type fileMatch string
+
+func (f fileMatch) Skip(finding string) bool {
+	file := findingFile(finding)
+	if file == "" {
+		return false
+	}
+	pattern := string(f)
+	if dir := strings.TrimSuffix(pattern, "/**"); dir != pattern {
+		return underDir(filepath.FromSlash(dir), file)
+	}
+	return matchFile(pattern, file)
+}
+
+// underDir returns true if file is below dir. Relative paths are relative to the
+// current directory.
+func underDir(dir, file string) bool {
+	if filepath.IsAbs(dir) != filepath.IsAbs(file) {
+		abs, err := filepath.Abs(file)
+		if err != nil {
+			return false
+		}
+		if dir, err = filepath.Abs(dir); err != nil {
+			return false
+		}
+		file = abs
+	}
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(file))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// ruleMatch skips findings reporting a rule.
+type ruleMatch string
+
+func (r ruleMatch) Skip(finding string) bool {
+	ids := ruleIDRE.FindAllStringSubmatch(finding, -1)
+	return ids != nil && ids[len(ids)-1][1] == string(r)
+}
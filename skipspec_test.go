@@ -0,0 +1,101 @@
+package lint_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestParseSkip(t *testing.T) {
+	const (
+		vendored = "vendor/example.com/x/x.go:1:1: exported X should have comment"
+		mainRule = "cmd/main.go:3:2: bad regexp (SA1000)"
+		libRule  = "lib/lib.go:3:2: bad regexp (SA1000)"
+		mainOK   = "cmd/main.go:5:1: unused variable x"
+		noPos    = "bad regexp (SA1000)"
+	)
+	parse := func(spec string) lint.Skipper {
+		s, err := lint.ParseSkip(spec)
+		if err != nil {
+			t.Fatalf("%s: %v", spec, err)
+		}
+		return s
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	complex := parse("file:vendor/** or (rule:SA1000 and file:main.go)")
+	testutil.TestSkips(t, []testutil.SkipTest{
+		// Matches
+		{S: parse("file:vendor/**"), Line: vendored, Skip: true},
+		{S: parse("file:vendor/**"), Line: "lint.Stub: " + filepath.Join(wd, "vendor", "a", "a.go") + ":1: x", Skip: true},
+		{S: parse("file:vendor/**"), Line: "./vendor/a.go:1: x", Skip: true},
+		{S: parse("file:vendor/**"), Line: "lint.Stub: /src/p/vendor/a/a.go:1: x", Skip: false},
+		{S: parse("file:vendor/**"), Line: "lib/vendor/a.go:1: x", Skip: false},
+		{S: parse("file:vendor/**"), Line: "notvendor/a.go:1: x", Skip: false},
+		{S: parse("file:vendor/**"), Line: "vendor.go:1: x", Skip: false},
+		{S: parse("file:/src/p/vendor/**"), Line: "/src/p/vendor/a/a.go:1: x", Skip: true},
+		{S: parse("file:main.go"), Line: mainOK, Skip: true},
+		{S: parse("file:cmd/*.go"), Line: libRule, Skip: false},
+		{S: parse("rule:SA1000"), Line: noPos, Skip: true},
+		{S: parse("rule:SA1000"), Line: mainOK, Skip: false},
+		{S: parse(`regexp:"unused (variable|const)"`), Line: mainOK, Skip: true},
+		{S: parse("pkg:github.com/surullabs/lint/errcheck"), Line: "errcheck/errcheck.go:1:1: x", Skip: true},
+
+		// Operators
+		{S: parse("rule:SA1000 and file:main.go"), Line: mainRule, Skip: true},
+		{S: parse("rule:SA1000 and file:main.go"), Line: libRule, Skip: false},
+		{S: parse("rule:SA1000 or file:main.go"), Line: libRule, Skip: true},
+		{S: parse("rule:SA1000 or file:main.go"), Line: vendored, Skip: false},
+		{S: parse("not file:main.go"), Line: libRule, Skip: true},
+		{S: parse("not file:main.go"), Line: mainRule, Skip: false},
+		{S: parse("not not file:main.go"), Line: mainRule, Skip: true},
+
+		// Precedence and parentheses
+		{S: complex, Line: vendored, Skip: true},
+		{S: complex, Line: mainRule, Skip: true},
+		{S: complex, Line: libRule, Skip: false},
+		{S: complex, Line: mainOK, Skip: false},
+		{S: parse("file:main.go or rule:SA1000 and file:lib.go"), Line: mainOK, Skip: true},
+		{S: parse("(file:main.go or rule:SA1000) and file:lib.go"), Line: mainOK, Skip: false},
+		{S: parse("not file:main.go and rule:SA1000"), Line: libRule, Skip: true},
+		{S: parse("not (file:main.go and rule:SA1000)"), Line: mainRule, Skip: false},
+		{S: parse("((rule:SA1000))"), Line: libRule, Skip: true},
+	})
+
+	for _, test := range []struct{ spec, err string }{
+		{"", "unexpected end"},
+		{"file:a.go and", "unexpected end"},
+		{"(file:a.go", "missing )"},
+		{"file:a.go)", `unexpected ")"`},
+		{"file:a.go rule:SA1000", `unexpected "rule:SA1000"`},
+		{"line:3", `unknown kind "line"`},
+		{"file:", "missing value"},
+		{"main.go", `unexpected "main.go"`},
+		{`regexp:"(`, "unterminated string"},
+		{"regexp:a[", "bad regexp"},
+	} {
+		s, err := lint.ParseSkip(test.spec)
+		assert(t, s == nil && err != nil && strings.Contains(err.Error(), test.err), fmt.Sprintf("%q: %v", test.spec, err))
+	}
+}
+
+func TestSkipCombinators(t *testing.T) {
+	a, b := lint.RegexpMatch("a"), lint.RegexpMatch("b")
+	testutil.TestSkips(t, []testutil.SkipTest{
+		{S: lint.And(a, b), Line: "ab", Skip: true},
+		{S: lint.And(a, b), Line: "a", Skip: false},
+		{S: lint.Or(a, b), Line: "b", Skip: true},
+		{S: lint.Or(a, b), Line: "c", Skip: false},
+		{S: lint.Not(a), Line: "c", Skip: true},
+		{S: lint.Not(a), Line: "a", Skip: false},
+		{S: lint.And(), Line: "a", Skip: false},
+		{S: lint.Or(), Line: "a", Skip: false},
+	})
+}
@@ -0,0 +1,37 @@
+// Package sloglint provides lint integration for the sloglint linter
+package sloglint
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the sloglint linter (https://github.com/go-simpler/sloglint)
+type Check struct {
+	// Command sets the environment and working directory used to run sloglint
+	checkers.Command
+	// KVOnly requires key-value pairs to be used instead of attributes
+	KVOnly bool
+	// AttrOnly requires attributes to be used instead of key-value pairs
+	AttrOnly bool
+	// NoGlobal reports the use of global loggers. It may be "all" to report any
+	// global logger, or "default" to report only the default logger.
+	NoGlobal string
+}
+
+// Check runs sloglint and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "sloglint", "", "go-simpler.org/sloglint/cmd/sloglint", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for sloglint
+func (c Check) Args() []string {
+	var args []string
+	if c.KVOnly {
+		args = append(args, "-kv-only")
+	}
+	if c.AttrOnly {
+		args = append(args, "-attr-only")
+	}
+	if c.NoGlobal != "" {
+		args = append(args, "-no-global", c.NoGlobal)
+	}
+	return args
+}
@@ -0,0 +1,88 @@
+package sloglint_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/sloglint"
+	"github.com/surullabs/lint/testutil"
+)
+
+const attrs = `package sloglinttest
+
+import "log/slog"
+
+// Log is a test function
+func Log(logger *slog.Logger) {
+	logger.Info("started", slog.Int("workers", 4))
+}
+`
+
+const kvs = `package sloglinttest
+
+import "log/slog"
+
+// Log is a test function
+func Log(logger *slog.Logger) {
+	logger.Info("started", "workers", 4)
+}
+`
+
+func TestSloglint(t *testing.T) {
+	testutil.Test(t, "sloglinttest", []testutil.StaticCheckTest{
+		{
+			Checker:  sloglint.Check{KVOnly: true},
+			Content:  []byte(kvs),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  sloglint.Check{KVOnly: true},
+			Content:  []byte(attrs),
+			Validate: testutil.Contains("attributes should not be used"),
+		},
+		{
+			Checker:  sloglint.Check{AttrOnly: true},
+			Content:  []byte(attrs),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  sloglint.Check{AttrOnly: true},
+			Content:  []byte(kvs),
+			Validate: testutil.Contains("key-value pairs should not be used"),
+		},
+		{
+			Checker: sloglint.Check{},
+			Content: []byte(`package sloglinttest
+
+import "log/slog"
+
+// Log is a test function
+func Log(logger *slog.Logger) {
+	logger.Info("started", "workers", 4, slog.String("mode", "fast"))
+}
+`),
+			Validate: testutil.Contains("key-value pairs and attributes should not be mixed"),
+		},
+		{
+			Checker: sloglint.Check{NoGlobal: "default"},
+			Content: []byte(`package sloglinttest
+
+import "log/slog"
+
+// Log is a test function
+func Log() {
+	slog.Info("started")
+}
+`),
+			Validate: testutil.SkippedErrors(`global logger should not be used`),
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: sloglint.Check{}, Expected: nil},
+		{A: sloglint.Check{KVOnly: true}, Expected: []string{"-kv-only"}},
+		{A: sloglint.Check{AttrOnly: true}, Expected: []string{"-attr-only"}},
+		{A: sloglint.Check{NoGlobal: "all"}, Expected: []string{"-no-global", "all"}},
+	})
+}
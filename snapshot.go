@@ -0,0 +1,55 @@
+package lint
+
+import "sort"
+
+// Snapshot returns the findings in err in a normalized, sorted form suitable for
+// storing and comparing with DiffSnapshots. Line and column numbers are removed
+// so that unrelated edits which shift code around do not show up as differences.
+func Snapshot(err error) []string {
+	errs := findings(err)
+	snap := make([]string, len(errs))
+	for i, e := range errs {
+		snap[i] = stripPosition(e)
+	}
+	sort.Strings(snap)
+	return snap
+}
+
+// stripPosition removes the line and column from a finding, retaining the file.
+func stripPosition(finding string) string {
+	label, file, _, _, msg, ok := parseLabeled(finding)
+	if !ok {
+		return finding
+	}
+	if label != "" {
+		file = label + ": " + file
+	}
+	return file + ": " + msg
+}
+
+// DiffSnapshots compares two snapshots returned by Snapshot and returns the findings
+// that were added in updated and the findings that were removed from old. Duplicate
+// findings are counted, so a finding occurring twice in updated and once in old is
+// reported as added once.
+func DiffSnapshots(old, updated []string) (added, removed []string) {
+	counts := map[string]int{}
+	for _, s := range old {
+		counts[s]++
+	}
+	for _, s := range updated {
+		if counts[s] > 0 {
+			counts[s]--
+			continue
+		}
+		added = append(added, s)
+	}
+	for _, s := range old {
+		if counts[s] > 0 {
+			counts[s]--
+			removed = append(removed, s)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
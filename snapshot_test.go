@@ -0,0 +1,58 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+func TestSnapshot(t *testing.T) {
+	snap := lint.Snapshot(checkers.Error(
+		"b.go:10:5: second",
+		"govet.Check: a.go:3: first",
+		"no position",
+	))
+	expected := []string{"b.go: second", "govet.Check: a.go: first", "no position"}
+	assert(t, reflect.DeepEqual(snap, expected), fmt.Sprintf("%v", snap))
+
+	assert(t, len(lint.Snapshot(nil)) == 0, "expected empty snapshot")
+
+	// Shifted lines do not change the snapshot
+	shifted := lint.Snapshot(checkers.Error("b.go:12:1: second", "govet.Check: a.go:5: first", "no position"))
+	assert(t, reflect.DeepEqual(snap, shifted), fmt.Sprintf("%v", shifted))
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	for i, test := range []struct {
+		old, updated   []string
+		added, removed []string
+	}{
+		{
+			old:     []string{"a.go: x"},
+			updated: []string{"a.go: x", "b.go: y", "a.go: z"},
+			added:   []string{"a.go: z", "b.go: y"},
+		},
+		{
+			old:     []string{"a.go: x", "b.go: y"},
+			updated: []string{"b.go: y"},
+			removed: []string{"a.go: x"},
+		},
+		{
+			old:     []string{"a.go: x", "a.go: x", "b.go: y"},
+			updated: []string{"a.go: x", "c.go: z"},
+			added:   []string{"c.go: z"},
+			removed: []string{"a.go: x", "b.go: y"},
+		},
+		{
+			old:     []string{"a.go: x"},
+			updated: []string{"a.go: x"},
+		},
+	} {
+		added, removed := lint.DiffSnapshots(test.old, test.updated)
+		assert(t, reflect.DeepEqual(added, test.added), fmt.Sprintf("%d: added: %v", i, added))
+		assert(t, reflect.DeepEqual(removed, test.removed), fmt.Sprintf("%d: removed: %v", i, removed))
+	}
+}
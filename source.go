@@ -0,0 +1,86 @@
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+type withSource struct {
+	wrapped
+	context int
+}
+
+// WithSource returns a Checker that runs c and appends the source line reported by
+// each finding, with contextLines lines before and after it, beneath the finding.
+// A caret marks the reported column, as in
+//
+//    a.go:3:2: error return value not checked
+//        2 | func main() {
+//        3 | 	f.Close()
+//          | 	^
+//        4 | }
+//
+// Findings without a position, or whose file cannot be read or does not contain the
+// reported line, are returned unchanged.
+func WithSource(contextLines int, c Checker) Checker {
+	if contextLines < 0 {
+		contextLines = 0
+	}
+	return withSource{context: contextLines, wrapped: wrapped{c}}
+}
+
+func (w withSource) Check(pkgs ...string) error {
+	sources := map[string][]string{}
+	return mapFindings(w.checker.Check(pkgs...), func(finding string) string {
+		_, file, line, col, _, ok := parseLabeled(finding)
+		if !ok {
+			return finding
+		}
+		lines, cached := sources[file]
+		if !cached {
+			// Failures are cached too, so that they are not repeated for each finding.
+			if data, err := ioutil.ReadFile(file); err == nil {
+				lines = strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+			}
+			sources[file] = lines
+		}
+		if line < 1 || line > len(lines) {
+			return finding
+		}
+		return finding + "\n" + snippet(lines, line, col, w.context)
+	})
+}
+
+// snippet returns line of lines, with context lines around it, prefixed by line
+// numbers. A caret is printed beneath col, if it is set.
+func snippet(lines []string, line, col, context int) string {
+	first, last := line-context, line+context
+	if first < 1 {
+		first = 1
+	}
+	if last > len(lines) {
+		last = len(lines)
+	}
+	width := len(fmt.Sprint(last))
+	var out []string
+	for n := first; n <= last; n++ {
+		src := strings.TrimRight(lines[n-1], "\r")
+		out = append(out, fmt.Sprintf("    %*d | %s", width, n, src))
+		if n == line && col > 0 && col <= len(src)+1 {
+			out = append(out, fmt.Sprintf("    %*s | %s^", width, "", caretIndent(src[:col-1])))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// caretIndent returns whitespace with the width of prefix, retaining tabs so that
+// the caret is aligned with the source line above it.
+func caretIndent(prefix string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		return ' '
+	}, prefix)
+}
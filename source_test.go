@@ -0,0 +1,48 @@
+package lint_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestWithSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "a.go")
+	src := "package a\n\nfunc f() {\n\tg(x.Close())\n}\n"
+	if err = ioutil.WriteFile(file, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err = lint.WithSource(1, lint.Stub(
+		file+":4:4: error return value not checked",
+		file+":1: no column",
+		file+":99:1: out of range",
+		"missing.go:1:1: no file",
+		"no position",
+	)).Check()
+	expected := []string{
+		file + ":4:4: error return value not checked\n" +
+			"    3 | func f() {\n" +
+			"    4 | \tg(x.Close())\n" +
+			"      | \t  ^\n" +
+			"    5 | }",
+		file + ":1: no column\n" +
+			"    1 | package a\n" +
+			"    2 | ",
+		file + ":99:1: out of range",
+		"missing.go:1:1: no file",
+		"no position",
+	}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+	assert(t, lint.WithSource(2, lint.Stub()).Check() == nil, "expected no error")
+}
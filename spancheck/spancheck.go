@@ -0,0 +1,31 @@
+// Package spancheck provides lint integration for the spancheck linter
+package spancheck
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the spancheck linter (https://github.com/jjti/go-spancheck)
+type Check struct {
+	// Command sets the environment and working directory used to run spancheck
+	checkers.Command
+	// Checks is a list of checks to run. Valid checks are end, record-error and
+	// set-status. spancheck only runs the end check if it is empty.
+	Checks []string
+}
+
+// Check runs spancheck and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "spancheck", "", "github.com/jjti/go-spancheck/cmd/spancheck", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for spancheck
+func (c Check) Args() []string {
+	var args []string
+	if len(c.Checks) > 0 {
+		args = append(args, "-checks", strings.Join(c.Checks, ","))
+	}
+	return args
+}
@@ -0,0 +1,76 @@
+package spancheck_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/spancheck"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestSpancheck(t *testing.T) {
+	testutil.Test(t, "spanchecktest", []testutil.StaticCheckTest{
+		{
+			Checker: spancheck.Check{},
+			Content: []byte(`package spanchecktest
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+)
+
+// TestFunc is a test function
+func TestFunc(ctx context.Context) {
+	_, span := otel.Tracer("test").Start(ctx, "TestFunc")
+	defer span.End()
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: spancheck.Check{},
+			Content: []byte(`package spanchecktest
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+)
+
+// TestFunc is a test function
+func TestFunc(ctx context.Context) {
+	_, span := otel.Tracer("test").Start(ctx, "TestFunc")
+	span.AddEvent("started")
+}
+`),
+			Validate: testutil.Contains("span.End is not called on all paths"),
+		},
+		{
+			Checker: spancheck.Check{},
+			Content: []byte(`package spanchecktest
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+)
+
+// TestFunc is a test function
+func TestFunc(ctx context.Context) {
+	_, span := otel.Tracer("test").Start(ctx, "TestFunc")
+	span.AddEvent("started")
+}
+`),
+			Validate: testutil.SkippedErrors(`span\.End is not called`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: spancheck.Check{}, Expected: nil},
+		{A: spancheck.Check{Checks: []string{"end"}}, Expected: []string{"-checks", "end"}},
+		{A: spancheck.Check{Checks: []string{"end", "record-error", "set-status"}}, Expected: []string{"-checks", "end,record-error,set-status"}},
+	})
+}
@@ -0,0 +1,106 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// groupErrors is returned by Group, and checkers wrapping others, when one or more
+// checkers returned an OperationalError. It records which errors are operational,
+// so that they can be separated using Split even if they are identical to findings.
+type groupErrors struct {
+	errs []string
+	// op[i] is true if errs[i] is an operational error.
+	op []bool
+}
+
+func (g groupErrors) Errors() []string { return g.errs }
+func (g groupErrors) Error() string    { return strings.Join(g.errs, "\n") }
+
+// add appends the findings and operational errors in err, as returned by a Checker,
+// each prefixed with prefix.
+func (g *groupErrors) add(prefix string, err error) {
+	switch e := err.(type) {
+	case nil:
+	case checkers.OperationalError:
+		g.addOps(prefix + e.Error())
+	case groupErrors:
+		for i, line := range e.errs {
+			g.errs, g.op = append(g.errs, prefix+line), append(g.op, e.op[i])
+		}
+	default:
+		for _, f := range findings(err) {
+			g.addFindings(prefix + f)
+		}
+	}
+}
+
+// addFindings appends errs as findings.
+func (g *groupErrors) addFindings(errs ...string) {
+	for _, e := range errs {
+		g.errs, g.op = append(g.errs, e), append(g.op, false)
+	}
+}
+
+// addOps appends ops as operational errors.
+func (g *groupErrors) addOps(ops ...string) {
+	for _, o := range ops {
+		g.errs, g.op = append(g.errs, o), append(g.op, true)
+	}
+}
+
+// err returns nil if g is empty, g if it holds operational errors, or an error
+// holding its findings otherwise.
+func (g groupErrors) err() error {
+	for _, op := range g.op {
+		if op {
+			return g
+		}
+	}
+	return checkers.Error(g.errs...)
+}
+
+// withOps returns an error holding the findings in errs followed by the operational
+// errors in each of ops, such as those returned by Split.
+func withOps(errs []string, ops ...error) error {
+	var g groupErrors
+	g.addFindings(errs...)
+	for _, op := range ops {
+		g.addOps(findings(op)...)
+	}
+	return g.err()
+}
+
+// Split separates err into findings reported by linters and operational errors,
+// such as a linter that could not be installed or a package that could not be
+// parsed. This allows findings and operational errors to be reported differently,
+// for instance on stdout and stderr.
+//
+// Operational errors are those of type checkers.OperationalError, or those recorded
+// as such by Group. Split must be called on the error returned by a Checker, before
+// applying Skip, since Skip does not retain this information.
+//
+// Each returned error is either nil or implements the errors interface described in Skip.
+func Split(err error) (findings error, operational error) {
+	switch e := err.(type) {
+	case nil:
+		return nil, nil
+	case checkers.OperationalError:
+		return nil, checkers.Error(e.Error())
+	case groupErrors:
+		var errs, ops []string
+		for i, line := range e.errs {
+			if e.op[i] {
+				ops = append(ops, line)
+			} else {
+				errs = append(errs, line)
+			}
+		}
+		return checkers.Error(errs...), checkers.Error(ops...)
+	case errors:
+		return err, nil
+	default:
+		return checkers.Error(e.Error()), nil
+	}
+}
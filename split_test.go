@@ -0,0 +1,69 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+var missingTool = checkFn(func(...string) error {
+	return checkers.Operational(fmt.Errorf("failed to find binary: missing"))
+})
+
+func TestSplit(t *testing.T) {
+	err := lint.Group{twoErrors, missingTool, ungroupedError}.Check("./...")
+	// Operational errors are still part of the group error.
+	assert(t, err != nil && err.Error() ==
+		"lint_test.checkFn: err1\nlint_test.checkFn: err2\n"+
+			"lint_test.checkFn: failed to find binary: missing\nlint_test.checkFn: ungrouped: 1",
+		fmt.Sprintf("%v", err))
+
+	findings, ops := lint.Split(err)
+	assert(t, reflect.DeepEqual(errorList(findings), []string{
+		"lint_test.checkFn: err1", "lint_test.checkFn: err2", "lint_test.checkFn: ungrouped: 1",
+	}), fmt.Sprintf("%v", findings))
+	assert(t, reflect.DeepEqual(errorList(ops), []string{
+		"lint_test.checkFn: failed to find binary: missing",
+	}), fmt.Sprintf("%v", ops))
+
+	// Only findings
+	findings, ops = lint.Split(lint.Group{twoErrors}.Check("./..."))
+	assert(t, len(errorList(findings)) == 2 && ops == nil, fmt.Sprintf("%v, %v", findings, ops))
+
+	// Only operational errors
+	findings, ops = lint.Split(lint.Group{missingTool}.Check("./..."))
+	assert(t, findings == nil && len(errorList(ops)) == 1, fmt.Sprintf("%v, %v", findings, ops))
+
+	findings, ops = lint.Split(missingTool.Check("./..."))
+	assert(t, findings == nil && reflect.DeepEqual(errorList(ops), []string{"failed to find binary: missing"}),
+		fmt.Sprintf("%v, %v", findings, ops))
+
+	findings, ops = lint.Split(ungroupedError.Check("./..."))
+	assert(t, reflect.DeepEqual(errorList(findings), []string{"ungrouped: 1"}) && ops == nil,
+		fmt.Sprintf("%v, %v", findings, ops))
+
+	findings, ops = lint.Split(nil)
+	assert(t, findings == nil && ops == nil, fmt.Sprintf("%v, %v", findings, ops))
+}
+
+func TestSplitNested(t *testing.T) {
+	op := checkFn(func(...string) error {
+		return checkers.Operational(fmt.Errorf("same message"))
+	})
+	finding := checkFn(func(...string) error { return checkers.Error("same message") })
+
+	// Operational errors survive nested groups and FailFast, even when a
+	// finding has the same text.
+	for _, c := range []lint.Checker{
+		lint.Group{lint.Group{finding, op}},
+		lint.Group{lint.FailFast(lint.Group{op}), finding},
+		lint.FailFast(lint.Group{lint.Group{finding, op}}),
+	} {
+		findings, ops := lint.Split(c.Check("./..."))
+		assert(t, len(errorList(findings)) == 1 && len(errorList(ops)) == 1,
+			fmt.Sprintf("%T: %v, %v", c, findings, ops))
+	}
+}
@@ -0,0 +1,20 @@
+// Package sqlclosecheck provides lint integration for the sqlclosecheck linter
+package sqlclosecheck
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the sqlclosecheck linter (https://github.com/ryanrolds/sqlclosecheck)
+type Check struct {
+	// Command sets the environment and working directory used to run sqlclosecheck
+	checkers.Command
+}
+
+// Check runs sqlclosecheck and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "sqlclosecheck", "", "github.com/ryanrolds/sqlclosecheck", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for sqlclosecheck
+func (c Check) Args() []string {
+	return nil
+}
@@ -0,0 +1,74 @@
+package sqlclosecheck_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/sqlclosecheck"
+	"github.com/surullabs/lint/testutil"
+)
+
+const unclosed = `package sqlclosechecktest
+
+import "database/sql"
+
+// Count is a test function
+func Count(db *sql.DB) (int, error) {
+	rows, err := db.Query("SELECT COUNT(*) FROM users")
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			return 0, err
+		}
+	}
+	return n, rows.Err()
+}
+`
+
+func TestSqlclosecheck(t *testing.T) {
+	testutil.Test(t, "sqlclosechecktest", []testutil.StaticCheckTest{
+		{
+			Checker: sqlclosecheck.Check{},
+			Content: []byte(`package sqlclosechecktest
+
+import "database/sql"
+
+// Count is a test function
+func Count(db *sql.DB) (int, error) {
+	rows, err := db.Query("SELECT COUNT(*) FROM users")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var n int
+	for rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			return 0, err
+		}
+	}
+	return n, rows.Err()
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  sqlclosecheck.Check{},
+			Content:  []byte(unclosed),
+			Validate: testutil.Contains("Rows/Stmt"),
+		},
+		{
+			Checker:  sqlclosecheck.Check{},
+			Content:  []byte(unclosed),
+			Validate: testutil.SkippedErrors(`file\.go:7:.*was not closed`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: sqlclosecheck.Check{}, Expected: nil},
+	})
+}
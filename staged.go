@@ -0,0 +1,147 @@
+package lint
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Changed is a Checker that only checks packages containing the files returned by
+// Files. Findings are restricted to those files.
+type Changed struct {
+	Checker Checker
+	// Files returns the changed files. Paths are either absolute or relative to the
+	// current working directory.
+	Files func() ([]string, error)
+}
+
+// StagedChecker returns a Checker that runs c only for packages with .go files staged
+// for commit in git and reports only findings in staged files. It is intended
+// for use in pre-commit hooks.
+func StagedChecker(c Checker) Checker {
+	return Changed{Checker: c, Files: StagedFiles}
+}
+
+// RestrictToFiles returns a Checker that runs c for the packages containing files
+// and reports only findings in files, such as the files passed to a pre-commit
+// hook. Paths are either absolute or relative to the current working directory,
+// and are compared with those in findings after making both absolute.
+func RestrictToFiles(files []string, c Checker) Checker {
+	return Changed{Checker: c, Files: func() ([]string, error) { return files, nil }}
+}
+
+// Name returns the name of c.Checker.
+func (c Changed) Name() string { return checkerName(c.Checker) }
+
+// Category returns the category of c.Checker.
+func (c Changed) Category() string { return CategoryOf(c.Checker) }
+
+// Weight returns the weight of c.Checker.
+func (c Changed) Weight() int { return WeightOf(c.Checker) }
+
+// Check runs c.Checker for all packages containing a changed .go file. pkgs
+// is ignored since the packages to check are determined by c.Files.
+//
+// If there are no changed files nil is returned without running the Checker.
+// Findings without file information are always returned.
+func (c Changed) Check(pkgs ...string) error {
+	files, err := c.Files()
+	if err != nil {
+		return checkers.Operational(err)
+	}
+	changed := map[string]bool{}
+	dirs := map[string]bool{}
+	for _, f := range files {
+		if !strings.HasSuffix(f, ".go") {
+			continue
+		}
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to resolve %s: %v", f, err))
+		}
+		changed[abs] = true
+		dirs[filepath.Dir(abs)] = true
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	var changedPkgs []string
+	for dir := range dirs {
+		pkg, err := relativePackage(dir)
+		if err != nil {
+			return checkers.Operational(err)
+		}
+		changedPkgs = append(changedPkgs, pkg)
+	}
+	sort.Strings(changedPkgs)
+
+	err = c.Checker.Check(changedPkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	var errs []string
+	for _, e := range findings(found) {
+		if f := findingFile(e); f != "" {
+			if abs, err := filepath.Abs(f); err == nil && !changed[abs] {
+				continue
+			}
+		}
+		errs = append(errs, e)
+	}
+	return withOps(errs, ops)
+}
+
+// relativePackage returns a relative import path for dir, such as ./pkg.
+func relativePackage(dir string) (string, error) {
+	wd, err := filepath.Abs(".")
+	if err != nil {
+		return "", fmt.Errorf("failed to find cwd: %v", err)
+	}
+	rel, err := filepath.Rel(wd, dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to find package for %s: %v", dir, err)
+	}
+	rel = filepath.ToSlash(rel)
+	if !strings.HasPrefix(rel, ".") {
+		rel = "./" + rel
+	}
+	return rel, nil
+}
+
+// StagedFiles returns the absolute paths of all files staged for commit in the
+// git repository containing the current working directory. Deleted files are
+// not included.
+func StagedFiles() ([]string, error) {
+	root, err := git("", "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	out, err := git("", "diff", "--cached", "--name-only", "--diff-filter=ACMR")
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, f := range strings.Split(out, "\n") {
+		if f = strings.TrimSpace(f); f != "" {
+			files = append(files, filepath.Join(root, filepath.FromSlash(f)))
+		}
+	}
+	return files, nil
+}
+
+// git runs git with args in dir, or the current directory if dir is empty, and
+// returns its trimmed output. Failures are returned as operational errors.
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	res, err := checkers.Exec(cmd)
+	if err != nil {
+		return "", checkers.Operational(fmt.Errorf("git %s failed: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(res.Stderr)))
+	}
+	return strings.TrimSpace(res.Stdout), nil
+}
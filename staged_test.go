@@ -0,0 +1,109 @@
+package lint_test
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+// recorder is a Checker that records the packages it was run against and
+// returns errs as findings.
+type recorder struct {
+	pkgs []string
+	errs []string
+}
+
+func (r *recorder) Check(pkgs ...string) error {
+	r.pkgs = append(r.pkgs, pkgs...)
+	return checkers.Error(r.errs...)
+}
+
+func changedFiles(files ...string) func() ([]string, error) {
+	return func() ([]string, error) { return files, nil }
+}
+
+func TestChanged(t *testing.T) {
+	r := &recorder{errs: []string{
+		"a/a.go:1:1: staged finding",
+		"a/other.go:2:1: unstaged finding",
+		"b/b.go:3: staged finding in b",
+		"operational failure",
+	}}
+	c := lint.Changed{Checker: r, Files: changedFiles("a/a.go", "b/b.go", "b/README.md")}
+	err := c.Check("./...")
+	if expected := []string{"./a", "./b"}; !reflect.DeepEqual(r.pkgs, expected) {
+		t.Errorf("expected packages %v to be checked, got %v", expected, r.pkgs)
+	}
+	expected := []string{
+		"a/a.go:1:1: staged finding",
+		"b/b.go:3: staged finding in b",
+		"operational failure",
+	}
+	type errors interface {
+		Errors() []string
+	}
+	errs, ok := err.(errors)
+	if !ok {
+		t.Fatalf("unexpected error type: %v", err)
+	}
+	if !reflect.DeepEqual(errs.Errors(), expected) {
+		t.Errorf("expected %v, got %v", expected, errs.Errors())
+	}
+}
+
+func TestChangedNoFiles(t *testing.T) {
+	r := &recorder{errs: []string{"a/a.go:1:1: finding"}}
+	c := lint.Changed{Checker: r, Files: changedFiles("README.md")}
+	if err := c.Check("./..."); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if len(r.pkgs) != 0 {
+		t.Errorf("expected checker to not run, but it checked %v", r.pkgs)
+	}
+}
+
+func TestStagedCheckerGitFailure(t *testing.T) {
+	gitDir, set := os.LookupEnv("GIT_DIR")
+	defer func() {
+		if set {
+			os.Setenv("GIT_DIR", gitDir)
+		} else {
+			os.Unsetenv("GIT_DIR")
+		}
+	}()
+	os.Setenv("GIT_DIR", "/nonexistent")
+
+	r := &recorder{errs: []string{"a/a.go:1:1: finding"}}
+	err := lint.StagedChecker(r).Check("./...")
+	if _, ok := err.(checkers.OperationalError); !ok || !strings.Contains(err.Error(), "git rev-parse") {
+		t.Fatalf("expected an operational error, got %v", err)
+	}
+	findings, ops := lint.Split(lint.Group{lint.StagedChecker(r)}.Check("./..."))
+	if findings != nil || len(errorList(ops)) != 1 {
+		t.Errorf("expected only an operational error, got %v, %v", findings, ops)
+	}
+	if len(r.pkgs) != 0 {
+		t.Errorf("expected checker to not run, but it checked %v", r.pkgs)
+	}
+}
+
+func TestRestrictToFiles(t *testing.T) {
+	r := &recorder{errs: []string{
+		"a/a.go:1:1: first",
+		"a/b.go:2:1: second",
+		"c/c.go:3:1: third",
+		"lint.Stub: ./a/a.go:4:1: labeled",
+	}}
+	err := lint.RestrictToFiles([]string{"./a/a.go"}, r).Check("./...")
+	if expected := []string{"./a"}; !reflect.DeepEqual(r.pkgs, expected) {
+		t.Errorf("expected packages %v to be checked, got %v", expected, r.pkgs)
+	}
+	expected := []string{"a/a.go:1:1: first", "lint.Stub: ./a/a.go:4:1: labeled"}
+	if errs := errorList(err); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("expected %v, got %v", expected, errs)
+	}
+}
@@ -5,6 +5,8 @@ import "github.com/surullabs/lint/checkers"
 
 // Check runs the structcheck linter (https://github.com/opennota/check)
 type Check struct {
+	// Command sets the environment and working directory used to run structcheck
+	checkers.Command
 	// ReportExported reports exported fields that are unused
 	ReportExported bool
 	// OnlyCountAssignments ensures only assignments are counted
@@ -15,7 +17,7 @@ type Check struct {
 
 // Check runs structcheck and returns any errors found.
 func (c Check) Check(pkgs ...string) error {
-	return checkers.Lint("structcheck",
+	return checkers.LintCommand(c.Command, "structcheck",
 		"github.com/opennota/check",
 		"github.com/opennota/check/cmd/structcheck", pkgs, c.Args()...)
 }
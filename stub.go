@@ -0,0 +1,42 @@
+package lint
+
+import "github.com/surullabs/lint/checkers"
+
+// StubChecker is a Checker that returns a fixed error. It is intended for testing
+// code that uses checkers, without running any linters.
+type StubChecker struct {
+	// Label is returned by Name. If it is empty, "lint.Stub" is used.
+	Label string
+	// Err is returned by Check.
+	Err error
+}
+
+// Stub returns a StubChecker that reports findings.
+func Stub(findings ...string) StubChecker {
+	return StubChecker{Err: checkers.Error(findings...)}
+}
+
+// StubError returns a StubChecker that fails with err as a checkers.OperationalError.
+func StubError(err error) StubChecker {
+	return StubChecker{Err: checkers.Operational(err)}
+}
+
+// WithName returns a copy of s with Label set to name, allowing s to stand in
+// for another checker.
+func (s StubChecker) WithName(name string) StubChecker {
+	s.Label = name
+	return s
+}
+
+// Name implements Named.
+func (s StubChecker) Name() string {
+	if s.Label == "" {
+		return "lint.Stub"
+	}
+	return s.Label
+}
+
+// Check returns s.Err and ignores pkgs.
+func (s StubChecker) Check(pkgs ...string) error {
+	return s.Err
+}
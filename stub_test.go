@@ -0,0 +1,44 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+func TestStub(t *testing.T) {
+	err := lint.Stub("a.go:1: first", "b.go:2: second").Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), []string{"a.go:1: first", "b.go:2: second"}), fmt.Sprintf("%v", err))
+	assert(t, lint.Stub().Check("./...") == nil, "expected no error")
+
+	err = lint.StubError(fmt.Errorf("missing tool")).Check("./...")
+	_, ok := err.(checkers.OperationalError)
+	assert(t, ok && err.Error() == "missing tool", fmt.Sprintf("%v", err))
+
+	var named lint.Named = lint.Stub()
+	assert(t, named.Name() == "lint.Stub", named.Name())
+	named = lint.Stub().WithName("errcheck.Check")
+	assert(t, named.Name() == "errcheck.Check", named.Name())
+}
+
+func TestStubGroup(t *testing.T) {
+	err := lint.Group{
+		lint.Stub("a.go:1: unchecked error").WithName("errcheck.Check"),
+		lint.Stub(),
+		lint.StubError(fmt.Errorf("failed to find binary: golint")).WithName("golint.Check"),
+		lint.Stub("b.go:2: finding"),
+	}.Check("./...")
+	expected := []string{
+		"errcheck.Check: a.go:1: unchecked error",
+		"golint.Check: failed to find binary: golint",
+		"lint.Stub: b.go:2: finding",
+	}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%v", err))
+
+	findings, ops := lint.Split(err)
+	assert(t, len(errorList(findings)) == 2, fmt.Sprintf("%v", findings))
+	assert(t, reflect.DeepEqual(errorList(ops), expected[1:2]), fmt.Sprintf("%v", ops))
+}
@@ -0,0 +1,31 @@
+// Package tagliatelle provides lint integration for the tagliatelle linter
+package tagliatelle
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the tagliatelle linter (https://github.com/ldez/tagliatelle)
+type Check struct {
+	// Command sets the environment and working directory used to run tagliatelle
+	checkers.Command
+	// JSON is the case convention (camel, snake, kebab, ...) expected for json tags
+	JSON string
+	// YAML is the case convention (camel, snake, kebab, ...) expected for yaml tags
+	YAML string
+}
+
+// Check runs tagliatelle and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "tagliatelle", "", "github.com/ldez/tagliatelle/cmd/tagliatelle", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for tagliatelle
+func (c Check) Args() []string {
+	var args []string
+	if c.JSON != "" {
+		args = append(args, "-json", c.JSON)
+	}
+	if c.YAML != "" {
+		args = append(args, "-yaml", c.YAML)
+	}
+	return args
+}
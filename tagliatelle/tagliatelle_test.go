@@ -0,0 +1,56 @@
+package tagliatelle_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/tagliatelle"
+	"github.com/surullabs/lint/testutil"
+)
+
+func TestTagliatelle(t *testing.T) {
+	testutil.Test(t, "tagliatelletest", []testutil.StaticCheckTest{
+		{
+			Checker: tagliatelle.Check{JSON: "snake"},
+			Content: []byte(`package tagliatelletest
+
+// Config is a test struct
+type Config struct {
+	FooBar string ` + "`json:\"foo_bar\"`" + `
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: tagliatelle.Check{JSON: "snake"},
+			Content: []byte(`package tagliatelletest
+
+// Config is a test struct
+type Config struct {
+	FooBar string ` + "`json:\"fooBar\"`" + `
+}
+`),
+			Validate: testutil.Contains("json(fooBar) should be foo_bar"),
+		},
+		{
+			Checker: tagliatelle.Check{JSON: "snake"},
+			Content: []byte(`package tagliatelletest
+
+// Config is a test struct
+type Config struct {
+	FooBar string ` + "`json:\"fooBar\"`" + `
+}
+`),
+			Validate: testutil.SkippedErrors(`json\(fooBar\) should be foo_bar`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: tagliatelle.Check{}, Expected: nil},
+		{A: tagliatelle.Check{JSON: "snake"}, Expected: []string{"-json", "snake"}},
+		{A: tagliatelle.Check{YAML: "kebab"}, Expected: []string{"-yaml", "kebab"}},
+		{A: tagliatelle.Check{JSON: "camel", YAML: "snake"}, Expected: []string{"-json", "camel", "-yaml", "snake"}},
+	})
+}
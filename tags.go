@@ -0,0 +1,211 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+type multiTags struct {
+	wrapped
+	sets [][]string
+}
+
+// MultiTags returns a Checker that runs c once for each set of build tags in tagSets.
+// Each finding is prefixed with the tag sets that reported it, such as
+//
+//    tags=go1.20,linux|go1.21: a.go:12: unchecked error
+//
+// so that identical findings reported for several tag sets are only returned once.
+// An empty tag set is shown as (none).
+//
+// Tags are set using a Tags field of c, which is either a []string or a space
+// separated string as used by errcheck.Check. If c has no such field but embeds
+// checkers.Command, tags are passed to the linter using GOFLAGS, replacing only the
+// -tags flag of the GOFLAGS set in its Env or the environment. c may be a struct or
+// a pointer to one, which is not modified. An OperationalError is returned for
+// other checkers, including those wrapped by another Checker, as the fields of the
+// wrapped checker cannot be set.
+func MultiTags(tagSets [][]string, c Checker) Checker {
+	return multiTags{sets: tagSets, wrapped: wrapped{c}}
+}
+
+func (m multiTags) Check(pkgs ...string) error {
+	var variants []variant
+	for _, tags := range m.sets {
+		c, err := withTags(m.checker, tags)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("%s does not support build tags: %v", checkerName(m.checker), err))
+		}
+		label := strings.Join(tags, ",")
+		if label == "" {
+			label = "(none)"
+		}
+		variants = append(variants, variant{label: label, checker: c})
+	}
+	return checkVariants("tags=", variants, pkgs)
+}
+
+// variant is a copy of a checker configured differently, such as for a set of
+// build tags, along with the label used to identify it in findings.
+type variant struct {
+	label   string
+	checker Checker
+}
+
+// checkVariants runs each of variants and prefixes each finding with prefix and the
+// labels of the variants that reported it, so that identical findings are only
+// returned once.
+func checkVariants(prefix string, variants []variant, pkgs []string) error {
+	var order, ops []string
+	labels := map[string][]string{}
+	for _, v := range variants {
+		found, op := Split(v.checker.Check(pkgs...))
+		for _, f := range findings(found) {
+			if _, seen := labels[f]; !seen {
+				order = append(order, f)
+			}
+			labels[f] = append(labels[f], v.label)
+		}
+		for _, o := range findings(op) {
+			ops = append(ops, prefix+v.label+": "+o)
+		}
+	}
+	var errs []string
+	for _, f := range order {
+		errs = append(errs, prefix+strings.Join(labels[f], "|")+": "+f)
+	}
+	return withOps(errs, checkers.Error(ops...))
+}
+
+// withTags returns a copy of c using tags, as described in MultiTags. An error is
+// returned if c does not support build tags.
+func withTags(c Checker, tags []string) (Checker, error) {
+	copied, err := copyChecker(c)
+	if err != nil {
+		return nil, err
+	}
+	f, err := settableField(copied, "Tags")
+	if err != nil {
+		return nil, err
+	}
+	if f.IsValid() {
+		switch {
+		case f.Kind() == reflect.String:
+			f.SetString(strings.Join(tags, " "))
+		case f.Type() == reflect.TypeOf([]string(nil)):
+			f.Set(reflect.ValueOf(tags))
+		default:
+			return nil, fmt.Errorf("field Tags has unsupported type %s", f.Type())
+		}
+		return checkerOf(copied, c), nil
+	}
+	cmd, err := settableField(copied, "Command")
+	if err != nil {
+		return nil, err
+	}
+	if !cmd.IsValid() || cmd.Type() != reflect.TypeOf(checkers.Command{}) {
+		return nil, fmt.Errorf("it has no Tags field and does not embed checkers.Command")
+	}
+	env := cmd.Interface().(checkers.Command).Env
+	setEnv(cmd, "GOFLAGS="+withTagsFlag(goflags(env), tags))
+	return checkerOf(copied, c), nil
+}
+
+// goflags returns the GOFLAGS a command run with the additional environment env
+// uses, which is the last GOFLAGS in env or otherwise that of this process.
+func goflags(env []string) string {
+	flags := os.Getenv("GOFLAGS")
+	for _, e := range env {
+		if strings.HasPrefix(e, "GOFLAGS=") {
+			flags = strings.TrimPrefix(e, "GOFLAGS=")
+		}
+	}
+	return flags
+}
+
+// withTagsFlag returns flags, a GOFLAGS value, with any -tags flag replaced by one
+// setting tags.
+func withTagsFlag(flags string, tags []string) string {
+	var kept []string
+	for _, f := range strings.Fields(flags) {
+		if !strings.HasPrefix(f, "-tags=") && !strings.HasPrefix(f, "--tags=") {
+			kept = append(kept, f)
+		}
+	}
+	return strings.Join(append(kept, "-tags="+strings.Join(tags, ",")), " ")
+}
+
+// copyChecker returns an addressable copy of the struct c, or of the struct c
+// points to, so that its fields can be set without modifying c.
+func copyChecker(c Checker) (reflect.Value, error) {
+	v := reflect.ValueOf(c)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("it is not a struct or a pointer to a struct")
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	return copied, nil
+}
+
+// checkerOf returns copied, a copy made by copyChecker, as a Checker of the same
+// kind as orig.
+func checkerOf(copied reflect.Value, orig Checker) Checker {
+	if reflect.TypeOf(orig).Kind() == reflect.Ptr {
+		return copied.Addr().Interface().(Checker)
+	}
+	return copied.Interface().(Checker)
+}
+
+// settableField returns the field name of copied, or an invalid Value if there is
+// no such field. Fields which cannot be set or are promoted through an embedded
+// pointer, which would modify the original checker, result in an error.
+func settableField(copied reflect.Value, name string) (reflect.Value, error) {
+	sf, ok := copied.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}, nil
+	}
+	t := copied.Type()
+	for _, i := range sf.Index[:len(sf.Index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("field %s is promoted through an embedded %s", name, t)
+		}
+	}
+	f := copied.FieldByIndex(sf.Index)
+	if !f.CanSet() {
+		return reflect.Value{}, fmt.Errorf("field %s is not settable", name)
+	}
+	return f, nil
+}
+
+// setEnv sets env, of the form key=value, in the environment of cmd, a settable
+// checkers.Command, replacing any values it already holds for the same keys.
+func setEnv(cmd reflect.Value, env ...string) {
+	c := cmd.Interface().(checkers.Command)
+	var merged []string
+	for _, e := range c.Env {
+		if !hasEnvKey(env, e) {
+			merged = append(merged, e)
+		}
+	}
+	c.Env = append(merged, env...)
+	cmd.Set(reflect.ValueOf(c))
+}
+
+// hasEnvKey returns true if env holds a value for the key of e.
+func hasEnvKey(env []string, e string) bool {
+	key := strings.SplitN(e, "=", 2)[0] + "="
+	for _, v := range env {
+		if strings.HasPrefix(v, key) {
+			return true
+		}
+	}
+	return false
+}
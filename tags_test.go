@@ -0,0 +1,79 @@
+package lint_test
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+// taggedCheck reports a finding only for one of its build tags.
+type taggedCheck struct {
+	Tags string
+}
+
+func (c taggedCheck) Check(...string) error {
+	errs := []string{"a.go:1: common"}
+	if strings.Contains(c.Tags, "go1.20") {
+		errs = append(errs, "b.go:2: only go1.20")
+	}
+	return checkers.Error(errs...)
+}
+
+// commandCheck reports the environment it would run the linter with.
+type commandCheck struct {
+	checkers.Command
+}
+
+func (c commandCheck) Check(...string) error { return checkers.Error(c.Env...) }
+
+type pointerTags struct{ *taggedCheck }
+
+func TestMultiTags(t *testing.T) {
+	sets := [][]string{{"go1.20", "linux"}, {"go1.21"}}
+	err := lint.MultiTags(sets, taggedCheck{}).Check("./...")
+	expected := []string{
+		"tags=go1.20,linux|go1.21: a.go:1: common",
+		"tags=go1.20,linux: b.go:2: only go1.20",
+	}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	// Pointers are copied rather than modified.
+	tagged := &taggedCheck{}
+	err = lint.MultiTags(sets, tagged).Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), expected) && tagged.Tags == "", fmt.Sprintf("%q", errorList(err)))
+
+	// Other flags in GOFLAGS are kept.
+	cmd := commandCheck{checkers.Command{Env: []string{"CGO_ENABLED=0", "GOFLAGS=-mod=vendor -tags=old"}}}
+	err = lint.MultiTags([][]string{nil, {"integration"}}, cmd).Check()
+	expected = []string{
+		"tags=(none)|integration: CGO_ENABLED=0",
+		"tags=(none): GOFLAGS=-mod=vendor -tags=",
+		"tags=integration: GOFLAGS=-mod=vendor -tags=integration",
+	}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+	assert(t, len(cmd.Env) == 2 && cmd.Env[1] == "GOFLAGS=-mod=vendor -tags=old", fmt.Sprintf("%q", cmd.Env))
+
+	defer os.Setenv("GOFLAGS", os.Getenv("GOFLAGS"))
+	os.Setenv("GOFLAGS", "-mod=mod")
+	err = lint.MultiTags([][]string{{"integration"}}, commandCheck{}).Check()
+	expected = []string{"tags=integration: GOFLAGS=-mod=mod -tags=integration"}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	for _, test := range []struct {
+		c   lint.Checker
+		msg string
+	}{
+		{twoErrors, "it is not a struct or a pointer to a struct"},
+		{lint.Advisory(nil, taggedCheck{}), "it has no Tags field and does not embed checkers.Command"},
+		{pointerTags{&taggedCheck{}}, "field Tags is promoted through an embedded *lint_test.taggedCheck"},
+	} {
+		err = lint.MultiTags(sets, test.c).Check()
+		_, ok := err.(checkers.OperationalError)
+		assert(t, ok && strings.HasSuffix(err.Error(), " does not support build tags: "+test.msg), fmt.Sprintf("%v", err))
+	}
+}
@@ -0,0 +1,76 @@
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Formatter formats a Report, such as FormatJSON, FormatJUnit, FormatSARIF or
+// FormatCheckstyle.
+type Formatter func(report *Report) ([]byte, error)
+
+type tee struct {
+	wrapped
+	path   string
+	format Formatter
+}
+
+// Tee returns a Checker that runs c, writes its findings to path formatted using
+// format, and returns the error returned by c unchanged. This allows findings to
+// be saved as an artifact, such as in CI, while still failing the build. The
+// directory containing path is created if required. If c reports an operational
+// error path is not written, since the findings are incomplete and a report
+// without them would look clean.
+//
+// Failing to write path is reported as an operational error, in addition to the
+// findings of c.
+//
+//    lint.Tee("reports/golint.xml", lint.FormatJUnit, golint.Check{})
+func Tee(path string, format Formatter, c Checker) Checker {
+	return tee{path: path, format: format, wrapped: wrapped{c}}
+}
+
+func (t tee) Check(pkgs ...string) error {
+	err := t.checker.Check(pkgs...)
+	found, ops := Split(err)
+	if ops != nil {
+		return err
+	}
+	werr := t.write(found)
+	if werr == nil {
+		return err
+	}
+	op := werr.Error()
+	if err == nil {
+		return checkers.Operational(werr)
+	}
+	var res groupErrors
+	res.add("", err)
+	res.addOps(op)
+	return res.err()
+}
+
+// write writes the findings in found to t.path.
+func (t tee) write(found error) error {
+	res := Result{Checker: checkerName(t.checker), Category: CategoryOf(t.checker), Findings: findings(found)}
+	for _, f := range res.Findings {
+		res.Severities = append(res.Severities, SeverityOf(t.checker, f))
+	}
+	report := &Report{ByCategory: map[string]int{}, BySeverity: map[Severity]int{}}
+	report.add(res)
+	data, err := t.format(report)
+	if err != nil {
+		return fmt.Errorf("failed to format findings for %s: %v", t.path, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(t.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %v", t.path, err)
+	}
+	if err = ioutil.WriteFile(t.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write findings: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package lint_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+)
+
+func TestTee(t *testing.T) {
+	dir, err := ioutil.TempDir("", "linttee")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "reports", "lint.json")
+	stub := lint.Stub("a.go:1:1: first", "b.go:2:1: second")
+	orig := stub.Check()
+	err = lint.Tee(path, lint.FormatJSON, stub).Check()
+	assert(t, reflect.DeepEqual(err, orig), fmt.Sprintf("%v", err))
+
+	data, rerr := ioutil.ReadFile(path)
+	assert(t, rerr == nil, fmt.Sprintf("%v", rerr))
+	var written []struct {
+		Checker  string
+		Findings []string
+	}
+	assert(t, json.Unmarshal(data, &written) == nil, string(data))
+	assert(t, len(written) == 1 && reflect.DeepEqual(written[0].Findings, errorList(orig)), string(data))
+
+	// Findings can be written in any of the supported formats.
+	sarif := filepath.Join(dir, "reports", "lint.sarif")
+	err = lint.Tee(sarif, lint.FormatSARIF, stub).Check()
+	assert(t, reflect.DeepEqual(err, orig), fmt.Sprintf("%v", err))
+	data, rerr = ioutil.ReadFile(sarif)
+	assert(t, rerr == nil && strings.Contains(string(data), `"uri": "b.go"`), string(data))
+
+	// Runs without findings are written too.
+	assert(t, lint.Tee(path, lint.FormatJSON, lint.Stub()).Check() == nil, "expected no error")
+	data, _ = ioutil.ReadFile(path)
+	assert(t, strings.Contains(string(data), `"findings": []`), string(data))
+
+	// Incomplete runs are not written.
+	assert(t, ioutil.WriteFile(path, []byte("previous"), 0644) == nil, "failed to write")
+	err = lint.Tee(path, lint.FormatJSON, lint.Group{stub, missingTool}).Check()
+	_, ops := lint.Split(err)
+	assert(t, ops != nil, fmt.Sprintf("%v", err))
+	data, _ = ioutil.ReadFile(path)
+	assert(t, string(data) == "previous", string(data))
+
+	// Write failures do not mask findings.
+	blocked := filepath.Join(dir, "file")
+	assert(t, ioutil.WriteFile(blocked, nil, 0644) == nil, "failed to create file")
+	err = lint.Tee(filepath.Join(blocked, "lint.json"), lint.FormatJSON, stub).Check()
+	found, ops := lint.Split(err)
+	assert(t, reflect.DeepEqual(errorList(found), errorList(orig)), fmt.Sprintf("%v", err))
+	assert(t, ops != nil && strings.Contains(ops.Error(), "failed to create directory"), fmt.Sprintf("%v", ops))
+
+	err = lint.Tee(filepath.Join(blocked, "lint.json"), lint.FormatJSON, lint.Stub()).Check()
+	_, ops = lint.Split(err)
+	assert(t, ops != nil, fmt.Sprintf("%v", err))
+}
+
+func TestFormatJSON(t *testing.T) {
+	report := &lint.Report{Results: []lint.Result{
+		{Checker: "errcheck.Check", Category: lint.CategoryCorrectness, Findings: []string{"a.go:1:1: f.Close()"}},
+		{Checker: "golint.Check", Category: lint.CategoryStyle},
+	}}
+	data, err := lint.FormatJSON(report)
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	var parsed []map[string]interface{}
+	assert(t, json.Unmarshal(data, &parsed) == nil, string(data))
+	assert(t, len(parsed) == 2 && parsed[0]["checker"] == "errcheck.Check" && parsed[1]["category"] == lint.CategoryStyle, string(data))
+	assert(t, reflect.DeepEqual(parsed[1]["findings"], []interface{}{}), string(data))
+}
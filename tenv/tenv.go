@@ -0,0 +1,26 @@
+// Package tenv provides lint integration for the tenv linter
+package tenv
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the tenv linter (https://github.com/sivchari/tenv)
+type Check struct {
+	// Command sets the environment and working directory used to run tenv
+	checkers.Command
+	// All reports os.Setenv in all functions of test files, not only in tests
+	All bool
+}
+
+// Check runs tenv and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "tenv", "", "github.com/sivchari/tenv/cmd/tenv", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for tenv
+func (c Check) Args() []string {
+	var args []string
+	if c.All {
+		args = append(args, "-all")
+	}
+	return args
+}
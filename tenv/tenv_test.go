@@ -0,0 +1,69 @@
+package tenv_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/tenv"
+	"github.com/surullabs/lint/testutil"
+)
+
+const pkg = `package tenvtest
+`
+
+func TestTenv(t *testing.T) {
+	testutil.Test(t, "tenvtest", []testutil.StaticCheckTest{
+		{
+			Checker: tenv.Check{},
+			Content: []byte(pkg),
+			Files: map[string][]byte{"env_test.go": []byte(`package tenvtest
+
+import "testing"
+
+func TestX(t *testing.T) {
+	t.Setenv("KEY", "value")
+}
+`)},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: tenv.Check{},
+			Content: []byte(pkg),
+			Files: map[string][]byte{"env_test.go": []byte(`package tenvtest
+
+import (
+	"os"
+	"testing"
+)
+
+func TestX(t *testing.T) {
+	os.Setenv("KEY", "value")
+}
+`)},
+			Validate: testutil.Contains("os.Setenv() can be replaced by `t.Setenv()` in TestX"),
+		},
+		{
+			Checker: tenv.Check{},
+			Content: []byte(pkg),
+			Files: map[string][]byte{"env_test.go": []byte(`package tenvtest
+
+import (
+	"os"
+	"testing"
+)
+
+func TestX(t *testing.T) {
+	os.Setenv("KEY", "value")
+}
+`)},
+			Validate: testutil.SkippedErrors(`can be replaced by .t\.Setenv\(\).`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: tenv.Check{}, Expected: nil},
+		{A: tenv.Check{All: true}, Expected: []string{"-all"}},
+	})
+}
@@ -0,0 +1,81 @@
+// Package testconsistency provides a lint check which verifies that the tests of a
+// package are either all internal or all external.
+package testconsistency
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check reports directories whose _test.go files mix internal tests, in package
+// foo, and external tests, in package foo_test. The test files of the less common
+// kind are reported, or the external tests if there are as many of each. An
+// export_test.go file, which exports internals to external tests, is allowed.
+type Check struct {
+}
+
+// Check checks the test files in pkgs.
+func (c Check) Check(pkgs ...string) error {
+	var errs []string
+	for _, pkg := range pkgs {
+		p, err := checkers.Load(pkg)
+		if err != nil {
+			return checkers.Operational(fmt.Errorf("failed to load pkg info: %s: %v", pkg, err))
+		}
+		byDir := map[string][]string{}
+		var dirs []string
+		for _, f := range p.Files {
+			if !strings.HasSuffix(f, "_test.go") || filepath.Base(f) == "export_test.go" {
+				continue
+			}
+			dir := filepath.Dir(f)
+			if _, ok := byDir[dir]; !ok {
+				dirs = append(dirs, dir)
+			}
+			byDir[dir] = append(byDir[dir], f)
+		}
+		for _, dir := range dirs {
+			found, err := checkDir(byDir[dir])
+			if err != nil {
+				return err
+			}
+			errs = append(errs, found...)
+		}
+	}
+	return checkers.Error(errs...)
+}
+
+// checkDir checks the test files of a single directory.
+func checkDir(files []string) ([]string, error) {
+	var internal, external []string
+	for _, file := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return nil, checkers.Operational(fmt.Errorf("failed to parse %s: %v", file, err))
+		}
+		pos := fmt.Sprintf("%s:%d", file, fset.Position(f.Name.Pos()).Line)
+		if strings.HasSuffix(f.Name.Name, "_test") {
+			external = append(external, pos)
+		} else {
+			internal = append(internal, pos)
+		}
+	}
+	if len(internal) == 0 || len(external) == 0 {
+		return nil, nil
+	}
+	reported := external
+	if len(internal) < len(external) {
+		reported = internal
+	}
+	var errs []string
+	for _, pos := range reported {
+		errs = append(errs, pos+": mixed internal/external test packages in directory")
+	}
+	return errs, nil
+}
@@ -0,0 +1,84 @@
+package testconsistency_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/testconsistency"
+	"github.com/surullabs/lint/testutil"
+)
+
+const source = `package testconsistencytest
+
+// Double is a test function
+func Double(x int) int {
+	return 2 * x
+}
+`
+
+const internalTest = `package testconsistencytest
+
+import "testing"
+
+func TestDouble(t *testing.T) {
+	if Double(2) != 4 {
+		t.Fatal("bad double")
+	}
+}
+`
+
+const externalTest = `package testconsistencytest_test
+
+import (
+	"testing"
+
+	"testconsistencytest"
+)
+
+func TestDoubleExternal(t *testing.T) {
+	if testconsistencytest.Double(2) != 4 {
+		t.Fatal("bad double")
+	}
+}
+`
+
+func TestTestConsistency(t *testing.T) {
+	testutil.Test(t, "testconsistencytest", []testutil.StaticCheckTest{
+		{
+			Checker: testconsistency.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"a_test.go": []byte(internalTest),
+				"b_test.go": []byte(internalTest),
+			},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: testconsistency.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"a_test.go":      []byte(externalTest),
+				"export_test.go": []byte("package testconsistencytest\n\n// Doubler exports Double\nvar Doubler = Double\n"),
+			},
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: testconsistency.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"a_test.go": []byte(internalTest),
+				"b_test.go": []byte(externalTest),
+				"c_test.go": []byte(externalTest),
+			},
+			Validate: testutil.HasSuffix("a_test.go:1: mixed internal/external test packages in directory"),
+		},
+		{
+			Checker: testconsistency.Check{},
+			Content: []byte(source),
+			Files: map[string][]byte{
+				"a_test.go": []byte(internalTest),
+				"b_test.go": []byte(externalTest),
+			},
+			Validate: testutil.SkippedErrors(`mixed internal/external test packages`),
+		},
+	})
+}
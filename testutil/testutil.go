@@ -12,6 +12,8 @@ import (
 
 	"reflect"
 
+	"testing"
+
 	"github.com/sridharv/fakegopath"
 	"github.com/surullabs/lint"
 	"github.com/surullabs/lint/checkers"
@@ -23,6 +25,9 @@ type StaticCheckTest struct {
 	File string
 	// Content is the content of the created file.
 	Content []byte
+	// Files holds the content of additional files to create in the package,
+	// keyed by file name.
+	Files map[string][]byte
 	// Checker is the checker to run on the package.
 	Checker lint.Checker
 	// Validate returns nil if err is what is expected.
@@ -32,9 +37,13 @@ type StaticCheckTest struct {
 // Test runs the test for pkg.
 func (s StaticCheckTest) Test(pkg string) error {
 	checkers.Unload(pkg)
-	tmp, err := fakegopath.NewTemporaryWithFiles(pkg, []fakegopath.SourceFile{
+	files := []fakegopath.SourceFile{
 		{Src: s.File, Content: s.Content, Dest: filepath.Join(pkg, "file.go")},
-	})
+	}
+	for name, content := range s.Files {
+		files = append(files, fakegopath.SourceFile{Content: content, Dest: filepath.Join(pkg, name)})
+	}
+	tmp, err := fakegopath.NewTemporaryWithFiles(pkg, files)
 	if err != nil {
 		return fmt.Errorf("failed to create temporary go path: %v", err)
 	}
@@ -42,6 +51,43 @@ func (s StaticCheckTest) Test(pkg string) error {
 	return s.Validate(s.Checker.Check(pkg))
 }
 
+// Benchmark runs c.Check on a temporary package holding content in the benchmark loop.
+// The package is created once, but cached package information is discarded before
+// each iteration so that every iteration loads the package as a first run would.
+//
+// Allocations are reported for the benchmark process only. Most checkers run the
+// linter as a separate process, whose time is included in ns/op but whose memory
+// is not, so allocs/op and B/op measure the overhead of the checker itself, such
+// as loading packages and parsing linter output.
+//
+//    func BenchmarkErrcheck(b *testing.B) {
+//    	testutil.Benchmark(b, errcheck.Check{}, content)
+//    }
+func Benchmark(b *testing.B, c lint.Checker, content []byte) {
+	const pkg = "lintbenchmark"
+	tmp, err := fakegopath.NewTemporaryWithFiles(pkg, []fakegopath.SourceFile{
+		{Content: content, Dest: filepath.Join(pkg, "file.go")},
+	})
+	if err != nil {
+		b.Fatalf("failed to create temporary go path: %v", err)
+	}
+	defer tmp.Reset()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		checkers.Unload(pkg)
+		b.StartTimer()
+		if err := c.Check(pkg); err != nil {
+			if _, ok := err.(checkers.OperationalError); ok {
+				b.Fatalf("check failed: %v", err)
+			}
+		}
+	}
+	b.StopTimer()
+	checkers.Unload(pkg)
+}
+
 // Errorer is used to report Errors. testing.T can be used as an Errorer.
 type Errorer interface {
 	Error(args ...interface{})
@@ -0,0 +1,106 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Transformer transforms a list of findings into another. Transformers must not
+// modify the slice they are passed.
+type Transformer func(findings []string) []string
+
+type pipe struct {
+	wrapped
+	transformers []Transformer
+}
+
+// Pipe returns a Checker that applies each of transformers, in order, to the
+// findings of c. The returned error implements the errors interface described
+// in Skip, even if the error returned by c does not. Operational errors are not
+// transformed and are retained.
+func Pipe(c Checker, transformers ...Transformer) Checker {
+	return pipe{wrapped: wrapped{c}, transformers: transformers}
+}
+
+func (p pipe) Check(pkgs ...string) error {
+	err := p.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	errs := findings(found)
+	for _, t := range p.transformers {
+		errs = t(errs)
+	}
+	return withOps(errs, ops)
+}
+
+// Sorted is a Transformer which sorts findings lexically.
+func Sorted(findings []string) []string {
+	sorted := make([]string, len(findings))
+	copy(sorted, findings)
+	sort.Strings(sorted)
+	return sorted
+}
+
+type deterministic struct {
+	wrapped
+}
+
+// Deterministic returns a Checker that runs c and returns its findings sorted, as
+// done by Sorted, irrespective of the order in which they were reported. Stateful
+// skippers, such as those which skip a number of findings, then behave the same on
+// every run. Unlike Pipe, operational errors returned by c are retained.
+func Deterministic(c Checker) Checker {
+	return deterministic{wrapped: wrapped{c}}
+}
+
+func (d deterministic) Check(pkgs ...string) error {
+	err := d.checker.Check(pkgs...)
+	if _, ok := err.(checkers.OperationalError); ok {
+		return err
+	}
+	found, ops := Split(err)
+	return withOps(Sorted(findings(found)), ops)
+}
+
+// Dedupe is a Transformer which removes repeated findings, retaining the first
+// occurrence of each.
+func Dedupe(findings []string) []string {
+	var deduped []string
+	seen := map[string]bool{}
+	for _, f := range findings {
+		if !seen[f] {
+			deduped = append(deduped, f)
+			seen[f] = true
+		}
+	}
+	return deduped
+}
+
+// Collapse replaces each run of identical consecutive findings in err with a
+// single finding annotated with the number of repetitions, such as
+//
+//     a.go:1: unused variable x (x3)
+//
+// Only exact duplicates are collapsed. Findings are split as done by Lines and the
+// returned error is built using Join.
+func Collapse(err error) error {
+	var collapsed []string
+	lines := Lines(err)
+	for i := 0; i < len(lines); {
+		n := 1
+		for i+n < len(lines) && lines[i+n] == lines[i] {
+			n++
+		}
+		if n == 1 {
+			collapsed = append(collapsed, lines[i])
+		} else {
+			collapsed = append(collapsed, fmt.Sprintf("%s (x%d)", lines[i], n))
+		}
+		i += n
+	}
+	return Join(collapsed)
+}
@@ -0,0 +1,120 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/surullabs/lint"
+	"github.com/surullabs/lint/checkers"
+)
+
+var unsorted = checkFn(func(...string) error {
+	return checkers.Error(
+		"/src/pkg/b.go:1: b",
+		"/src/pkg/a.go:2: a",
+		"/src/pkg/b.go:1: b",
+	)
+})
+
+func relativize(findings []string) []string {
+	rel := make([]string, len(findings))
+	for i, f := range findings {
+		rel[i] = strings.TrimPrefix(f, "/src/")
+	}
+	return rel
+}
+
+func errorList(err error) []string {
+	type errors interface {
+		Errors() []string
+	}
+	if e, ok := err.(errors); ok {
+		return e.Errors()
+	}
+	return nil
+}
+
+func TestPipe(t *testing.T) {
+	err := lint.Pipe(unsorted, relativize, lint.Sorted).Check("./...")
+	expected := []string{"pkg/a.go:2: a", "pkg/b.go:1: b", "pkg/b.go:1: b"}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%v", err))
+
+	err = lint.Pipe(unsorted, lint.Dedupe, relativize).Check("./...")
+	expected = []string{"pkg/b.go:1: b", "pkg/a.go:2: a"}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%v", err))
+
+	// Transformers are applied in order
+	var order []string
+	record := func(name string) lint.Transformer {
+		return func(findings []string) []string {
+			order = append(order, name)
+			return findings
+		}
+	}
+	_ = lint.Pipe(unsorted, record("first"), record("second")).Check("./...")
+	assert(t, reflect.DeepEqual(order, []string{"first", "second"}), fmt.Sprintf("%v", order))
+
+	// Ungrouped errors are transformed and all findings can be removed
+	err = lint.Pipe(ungroupedError, relativize).Check("./...")
+	assert(t, reflect.DeepEqual(errorList(err), []string{"ungrouped: 1"}), fmt.Sprintf("%v", err))
+	err = lint.Pipe(twoErrors, func([]string) []string { return nil }).Check("./...")
+	assert(t, err == nil, fmt.Sprintf("%v", err))
+	assert(t, lint.Pipe(expectRecursive, lint.Sorted).Check("./...") == nil, "expected no error")
+}
+
+func TestCollapse(t *testing.T) {
+	assert(t, lint.Collapse(nil) == nil, "expected nil for no findings")
+
+	err := lint.Collapse(checkers.Error(
+		"gen.go:1: unused x",
+		"gen.go:1: unused x",
+		"gen.go:1: unused x",
+		"gen.go:2: unused y",
+		"gen.go:1: unused x",
+		"gen.go:3: unused z",
+		"gen.go:3: unused z ",
+	))
+	expected := []string{
+		"gen.go:1: unused x (x3)",
+		"gen.go:2: unused y",
+		"gen.go:1: unused x",
+		"gen.go:3: unused z",
+		"gen.go:3: unused z ",
+	}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+
+	distinct := []string{"a.go:1: a", "b.go:2: b"}
+	err = lint.Collapse(fmt.Errorf("%s", strings.Join(distinct, "\n")))
+	assert(t, reflect.DeepEqual(errorList(err), distinct), fmt.Sprintf("%v", err))
+}
+
+// skipFirst is a stateful Skipper which skips the first n findings it sees.
+type skipFirst struct{ n int }
+
+func (s *skipFirst) Skip(string) bool {
+	s.n--
+	return s.n >= 0
+}
+
+func TestDeterministic(t *testing.T) {
+	orders := [][]string{
+		{"c.go:1: c", "a.go:1: a", "b.go:1: b", "d.go:1: d"},
+		{"d.go:1: d", "c.go:1: c", "b.go:1: b", "a.go:1: a"},
+		{"b.go:1: b", "d.go:1: d", "a.go:1: a", "c.go:1: c"},
+	}
+	expected := []string{"c.go:1: c", "d.go:1: d"}
+	for _, order := range orders {
+		err := lint.Skip(lint.Deterministic(lint.Stub(order...)).Check("./..."), &skipFirst{n: 2})
+		assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%v: %q", order, errorList(err)))
+	}
+
+	// Operational errors are retained after the sorted findings.
+	err := lint.Deterministic(lint.Group{lint.Stub("b.go:1: b", "a.go:1: a"), lint.StubError(fmt.Errorf("failed"))}).Check()
+	expected = []string{"lint.Stub: a.go:1: a", "lint.Stub: b.go:1: b", "lint.Stub: failed"}
+	assert(t, reflect.DeepEqual(errorList(err), expected), fmt.Sprintf("%q", errorList(err)))
+	_, ops := lint.Split(err)
+	assert(t, reflect.DeepEqual(errorList(ops), []string{"lint.Stub: failed"}), fmt.Sprintf("%v", ops))
+	assert(t, lint.Deterministic(lint.Stub()).Check() == nil, "expected no error")
+}
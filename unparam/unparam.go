@@ -0,0 +1,27 @@
+// Package unparam provides lint integration for the unparam linter
+package unparam
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the unparam linter (https://github.com/mvdan/unparam)
+type Check struct {
+	// Command sets the environment and working directory used to run unparam
+	checkers.Command
+	// CheckExported reports unused parameters and results of exported functions,
+	// which are skipped by default since changing them may break callers.
+	CheckExported bool
+}
+
+// Check runs unparam and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "unparam", "", "mvdan.cc/unparam", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for unparam
+func (c Check) Args() []string {
+	var args []string
+	if c.CheckExported {
+		args = append(args, "-exported")
+	}
+	return args
+}
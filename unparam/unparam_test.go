@@ -0,0 +1,59 @@
+package unparam_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/testutil"
+	"github.com/surullabs/lint/unparam"
+)
+
+const unused = `package unparamtest
+
+// Greet is a test function
+func Greet(name string) string {
+	return greet(name, 1) + greet(name, 2)
+}
+
+func greet(name string, times int) string {
+	return "hello " + name
+}
+`
+
+func TestUnparam(t *testing.T) {
+	testutil.Test(t, "unparamtest", []testutil.StaticCheckTest{
+		{
+			Checker: unparam.Check{},
+			Content: []byte(`package unparamtest
+
+import "strings"
+
+// Greet is a test function
+func Greet(name string) string {
+	return greet(name, 1) + greet(name, 2)
+}
+
+func greet(name string, times int) string {
+	return strings.Repeat("hello "+name, times)
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  unparam.Check{},
+			Content:  []byte(unused),
+			Validate: testutil.Contains("times is unused"),
+		},
+		{
+			Checker:  unparam.Check{},
+			Content:  []byte(unused),
+			Validate: testutil.SkippedErrors(`is unused`),
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: unparam.Check{}, Expected: nil},
+		{A: unparam.Check{CheckExported: true}, Expected: []string{"-exported"}},
+	})
+}
@@ -5,6 +5,8 @@ import "github.com/surullabs/lint/checkers"
 
 // Check runs the varcheck linter (https://github.com/opennota/check)
 type Check struct {
+	// Command sets the environment and working directory used to run varcheck
+	checkers.Command
 	// ReportExported reports exported variables that are unused
 	ReportExported bool
 }
@@ -14,7 +16,7 @@ func (c Check) Check(pkgs ...string) error {
 	if _, err := checkers.InstallMissing("varcheck", "github.com/opennota/check", "github.com/opennota/check/cmd/varcheck"); err != nil {
 		return err
 	}
-	return checkers.Lint("varcheck",
+	return checkers.LintCommand(c.Command, "varcheck",
 		"github.com/opennota/check",
 		"github.com/opennota/check/cmd/varcheck", pkgs, c.Args()...)
 }
@@ -0,0 +1,42 @@
+// Package varnamelen provides lint integration for the varnamelen linter
+package varnamelen
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/surullabs/lint/checkers"
+)
+
+// Check runs the varnamelen linter (https://github.com/blizzy78/varnamelen)
+type Check struct {
+	// Command sets the environment and working directory used to run varnamelen
+	checkers.Command
+	// MinNameLength is the minimum length of a variable name that is considered long
+	// enough. If zero, the varnamelen default is used.
+	MinNameLength int
+	// IgnoreNames are variable names that are never reported
+	IgnoreNames []string
+	// CheckReturn also checks named return values
+	CheckReturn bool
+}
+
+// Check runs varnamelen and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "varnamelen", "", "github.com/blizzy78/varnamelen/cmd/varnamelen", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for varnamelen
+func (c Check) Args() []string {
+	var args []string
+	if c.MinNameLength > 0 {
+		args = append(args, "-minNameLength", strconv.Itoa(c.MinNameLength))
+	}
+	if len(c.IgnoreNames) > 0 {
+		args = append(args, "-ignoreNames", strings.Join(c.IgnoreNames, ","))
+	}
+	if c.CheckReturn {
+		args = append(args, "-checkReturn")
+	}
+	return args
+}
@@ -0,0 +1,74 @@
+package varnamelen_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/testutil"
+	"github.com/surullabs/lint/varnamelen"
+)
+
+const shortName = `package varnamelentest
+
+import "fmt"
+
+// Sum is a test function
+func Sum(values []int) int {
+	t := 0
+	for _, value := range values {
+		t += value
+	}
+	fmt.Println("adding")
+	fmt.Println("values")
+	fmt.Println("together")
+	return t
+}
+`
+
+func TestVarnamelen(t *testing.T) {
+	testutil.Test(t, "varnamelentest", []testutil.StaticCheckTest{
+		{
+			Checker: varnamelen.Check{},
+			Content: []byte(`package varnamelentest
+
+import "fmt"
+
+// Sum is a test function
+func Sum(values []int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+	fmt.Println("adding")
+	fmt.Println("values")
+	fmt.Println("together")
+	return total
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  varnamelen.Check{},
+			Content:  []byte(shortName),
+			Validate: testutil.Contains("variable name 't' is too short for the scope of its usage"),
+		},
+		{
+			Checker:  varnamelen.Check{},
+			Content:  []byte(shortName),
+			Validate: testutil.SkippedErrors(`is too short for the scope of its usage`),
+		},
+		{
+			Checker:  varnamelen.Check{IgnoreNames: []string{"t"}},
+			Content:  []byte(shortName),
+			Validate: testutil.NoError,
+		},
+	})
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: varnamelen.Check{}, Expected: nil},
+		{A: varnamelen.Check{MinNameLength: 2}, Expected: []string{"-minNameLength", "2"}},
+		{A: varnamelen.Check{IgnoreNames: []string{"i", "ok"}}, Expected: []string{"-ignoreNames", "i,ok"}},
+		{A: varnamelen.Check{CheckReturn: true}, Expected: []string{"-checkReturn"}},
+	})
+}
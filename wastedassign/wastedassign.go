@@ -0,0 +1,20 @@
+// Package wastedassign provides lint integration for the wastedassign linter
+package wastedassign
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the wastedassign linter (https://github.com/sanposhiho/wastedassign)
+type Check struct {
+	// Command sets the environment and working directory used to run wastedassign
+	checkers.Command
+}
+
+// Check runs wastedassign and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "wastedassign", "", "github.com/sanposhiho/wastedassign/v2/cmd/wastedassign", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for wastedassign
+func (c Check) Args() []string {
+	return nil
+}
@@ -0,0 +1,60 @@
+package wastedassign_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/testutil"
+	"github.com/surullabs/lint/wastedassign"
+)
+
+func TestWastedassign(t *testing.T) {
+	testutil.Test(t, "wastedassigntest", []testutil.StaticCheckTest{
+		{
+			Checker: wastedassign.Check{},
+			Content: []byte(`package wastedassigntest
+
+// Sum is a test function
+func Sum() int {
+	x := 1
+	y := x + 1
+	x = 2
+	return x + y
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker: wastedassign.Check{},
+			Content: []byte(`package wastedassigntest
+
+// Value is a test function
+func Value() int {
+	x := 1
+	x = 2
+	return x
+}
+`),
+			Validate: testutil.Contains("reassigned without using the value"),
+		},
+		{
+			Checker: wastedassign.Check{},
+			Content: []byte(`package wastedassigntest
+
+// Value is a test function
+func Value() int {
+	x := 1
+	x = 2
+	return x
+}
+`),
+			Validate: testutil.SkippedErrors(`reassigned without using the value`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: wastedassign.Check{}, Expected: nil},
+	})
+}
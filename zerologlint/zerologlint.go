@@ -0,0 +1,20 @@
+// Package zerologlint provides lint integration for the zerologlint linter
+package zerologlint
+
+import "github.com/surullabs/lint/checkers"
+
+// Check runs the zerologlint linter (https://github.com/ykadowak/zerologlint)
+type Check struct {
+	// Command sets the environment and working directory used to run zerologlint
+	checkers.Command
+}
+
+// Check runs zerologlint and returns any errors found.
+func (c Check) Check(pkgs ...string) error {
+	return checkers.LintCommand(c.Command, "zerologlint", "", "github.com/ykadowak/zerologlint/cmd/zerologlint", pkgs, c.Args()...)
+}
+
+// Args returns command line arguments used for zerologlint
+func (c Check) Args() []string {
+	return nil
+}
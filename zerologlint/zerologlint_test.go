@@ -0,0 +1,53 @@
+package zerologlint_test
+
+import (
+	"testing"
+
+	"github.com/surullabs/lint/testutil"
+	"github.com/surullabs/lint/zerologlint"
+)
+
+const undispatched = `package zerologlinttest
+
+import "github.com/rs/zerolog/log"
+
+// TestFunc is a test function
+func TestFunc() {
+	log.Info().Str("key", "value")
+}
+`
+
+func TestZerologlint(t *testing.T) {
+	testutil.Test(t, "zerologlinttest", []testutil.StaticCheckTest{
+		{
+			Checker: zerologlint.Check{},
+			Content: []byte(`package zerologlinttest
+
+import "github.com/rs/zerolog/log"
+
+// TestFunc is a test function
+func TestFunc() {
+	log.Info().Str("key", "value").Msg("dispatched")
+}
+`),
+			Validate: testutil.NoError,
+		},
+		{
+			Checker:  zerologlint.Check{},
+			Content:  []byte(undispatched),
+			Validate: testutil.Contains("must be dispatched by Msg or Send method"),
+		},
+		{
+			Checker:  zerologlint.Check{},
+			Content:  []byte(undispatched),
+			Validate: testutil.SkippedErrors(`must be dispatched by Msg or Send method`),
+		},
+	},
+	)
+}
+
+func TestArgs(t *testing.T) {
+	testutil.TestArgs(t, []testutil.ArgTest{
+		{A: zerologlint.Check{}, Expected: nil},
+	})
+}